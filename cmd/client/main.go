@@ -7,8 +7,11 @@ import (
 	"time"
 
 	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/rs/xid"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 )
 
@@ -26,41 +29,113 @@ func main() {
 
 	client := enemy.NewEnemyServiceClient(conn)
 
+	// var reqs []*enemy.AddEnemyRequest
 	// for i := 0; i < 10; i++ {
-	// 	addEnemy(client, &enemy.AddEnemyRequest{
+	// 	reqs = append(reqs, &enemy.AddEnemyRequest{
 	// 		Name:   fmt.Sprintf("Enemy %d", i),
 	// 		Email:  fmt.Sprintf("enemy%d@bar.com", i),
 	// 		Rating: float32(i + 1),
 	// 	})
 	// }
+	// batchAddEnemies(client, reqs)
 
 	listEnemies(client)
 }
 
+// addEnemy retries on transient errors. The idempotency key makes sure a retry
+// of a call that actually succeeded doesn't add the enemy twice.
 func addEnemy(client enemy.EnemyServiceClient, req *enemy.AddEnemyRequest) {
-	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-	defer cancel()
-	res, err := client.AddEnemy(ctx, req)
+	if req.GetIdempotencyKey() == "" {
+		req.IdempotencyKey = xid.New().String()
+	}
+	var res *enemy.AddEnemyResponse
+	var err error
+	for attempt := 1; attempt <= 3; attempt++ {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		res, err = client.AddEnemy(ctx, req)
+		cancel()
+		if code := status.Code(err); code != codes.Unavailable && code != codes.DeadlineExceeded {
+			break
+		}
+		log.Printf("adding enemy failed: %v. Retrying", err)
+		time.Sleep(time.Duration(attempt) * time.Second)
+	}
+	if status.Code(err) == codes.AlreadyExists {
+		fmt.Printf("Enemy already exists: %s\n", status.Convert(err).Message())
+		return
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
 	fmt.Printf("Successfully added:\n%s\n", protojson.Format(res))
 }
 
-func listEnemies(client enemy.EnemyServiceClient) {
+// batchAddEnemies adds the enemies in one call, reporting the ones that
+// couldn't be added.
+func batchAddEnemies(client enemy.EnemyServiceClient, reqs []*enemy.AddEnemyRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	stream, err := client.BatchAddEnemies(ctx)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, req := range reqs {
+		if err := stream.Send(&enemy.BatchAddEnemiesRequest{Enemy: req}); err != nil {
+			log.Fatal(err)
+		}
+	}
+	res, err := stream.CloseAndRecv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	for i, result := range res.GetResults() {
+		if result.GetErrorCode() != int32(codes.OK) {
+			fmt.Printf("Failed to add %s: %s\n", reqs[i].GetName(), result.GetErrorMessage())
+			continue
+		}
+		fmt.Printf("Successfully added:\n%s\n", protojson.Format(result.GetEnemy()))
+	}
+}
+
+func getEnemy(client enemy.EnemyServiceClient, id string) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
-	res, err := client.ListEnemies(ctx, &enemy.ListEnemiesRequest{})
+	res, err := client.GetEnemy(ctx, &enemy.GetEnemyRequest{Id: id})
+	if status.Code(err) == codes.NotFound {
+		fmt.Printf("Enemy %s not found\n", id)
+		return
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
 	fmt.Println(protojson.Format(res))
 }
 
+func listEnemies(client enemy.EnemyServiceClient) {
+	req := &enemy.ListEnemiesRequest{PageSize: 100}
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		res, err := client.ListEnemies(ctx, req)
+		cancel()
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(protojson.Format(res))
+		if res.GetNextPageToken() == "" {
+			return
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+}
+
 func updateEnemy(client enemy.EnemyServiceClient, req *enemy.UpdateEnemyRequest) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	res, err := client.UpdateEnemy(ctx, req)
+	if status.Code(err) == codes.NotFound {
+		fmt.Printf("Enemy %s not found\n", req.GetId())
+		return
+	}
 	if err != nil {
 		log.Fatal(err)
 	}
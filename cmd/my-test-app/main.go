@@ -8,9 +8,11 @@ import (
 	"net"
 	"os"
 	"os/signal"
+	"strconv"
 	"syscall"
 	"time"
 
+	"github.com/jackc/pgx"
 	_ "github.com/jackc/pgx/stdlib"
 	"github.com/larwef/rpi-docker-test/internal/server"
 	"github.com/larwef/rpi-docker-test/internal/storage"
@@ -58,14 +60,60 @@ func realMain(ctx context.Context) error {
 	if err := PingRetry(ctx, db, 5*time.Second, 60*time.Second); err != nil {
 		return err
 	}
-	store, err := storage.NewEnemyStore(db)
+	var storeOpts []storage.Option
+	if kFactor := os.Getenv("ELO_K_FACTOR"); kFactor != "" {
+		k, err := strconv.ParseFloat(kFactor, 64)
+		if err != nil || k <= 0 {
+			return fmt.Errorf("invalid ELO_K_FACTOR %q", kFactor)
+		}
+		storeOpts = append(storeOpts, storage.WithKFactor(k))
+	}
+	switch aggregation := os.Getenv("VOTE_AGGREGATION"); aggregation {
+	case "", "mean":
+	case "median":
+		storeOpts = append(storeOpts, storage.WithVoteAggregation(storage.Median))
+	case "trimmed_mean":
+		storeOpts = append(storeOpts, storage.WithVoteAggregation(storage.TrimmedMean))
+	default:
+		return fmt.Errorf("invalid VOTE_AGGREGATION %q", aggregation)
+	}
+	store, err := storage.NewEnemyStore(db, storeOpts...)
 	if err != nil {
 		return fmt.Errorf("unable to initialize storage: %v", err)
 	}
 
-	opts := []grpc.ServerOption{}
+	// Listen for changes made through every replica.
+	connConfig, err := pgx.ParseConnectionString(dbURI)
+	if err != nil {
+		return fmt.Errorf("invalid db connection string: %v", err)
+	}
+	changes := make(chan *enemy.EnemyEvent)
+	go func() {
+		defer close(changes)
+		if err := storage.NewChangeListener(connConfig, store).Listen(ctx, changes); err != nil && ctx.Err() == nil {
+			log.Printf("listening for changes stopped: %v", err)
+		}
+	}()
+
+	opts := []grpc.ServerOption{
+		grpc.UnaryInterceptor(server.AuditUnaryInterceptor),
+		grpc.StreamInterceptor(server.AuditStreamInterceptor),
+	}
 	srv := grpc.NewServer(opts...)
-	enemy.RegisterEnemyServiceServer(srv, server.New(store))
+	var serverOpts []server.Option
+	if maxBatchSize := os.Getenv("MAX_BATCH_SIZE"); maxBatchSize != "" {
+		n, err := strconv.Atoi(maxBatchSize)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid MAX_BATCH_SIZE %q", maxBatchSize)
+		}
+		serverOpts = append(serverOpts, server.WithMaxBatchSize(n))
+	}
+	if token := os.Getenv("ADMIN_TOKEN"); token != "" {
+		serverOpts = append(serverOpts, server.WithAdminToken(token))
+	}
+	enemyServer := server.New(store, serverOpts...)
+	go enemyServer.PublishChanges(changes)
+	enemy.RegisterEnemyServiceServer(srv, enemyServer)
 
 	errCh := make(chan error)
 	go func() {
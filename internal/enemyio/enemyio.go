@@ -0,0 +1,252 @@
+// Package enemyio reads and writes enemies as CSV or newline delimited JSON,
+// for exporting and importing them.
+package enemyio
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Columns written to CSV files.
+var csvHeader = []string{"id", "name", "email", "rating", "lastUpdated", "deletedAt"}
+
+// Longest NDJSON line accepted.
+const maxLineLength = 1 << 20
+
+// LineError is a problem with a single line of the input. Reading can go on
+// after it.
+type LineError struct {
+	Line int
+	Msg  string
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Msg)
+}
+
+type Writer interface {
+	Write(e *enemy.Enemy) error
+	// Flush writes any buffered data to the underlying writer.
+	Flush() error
+}
+
+// NewWriter returns a Writer writing enemies to w in the given format. CSV
+// output starts with a header row.
+func NewWriter(w io.Writer, format enemy.Format) (Writer, error) {
+	switch format {
+	case enemy.Format_FORMAT_UNSPECIFIED, enemy.Format_CSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case enemy.Format_NDJSON:
+		return &ndjsonWriter{w: bufio.NewWriter(w)}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %v", format)
+	}
+}
+
+type csvWriter struct {
+	w             *csv.Writer
+	headerWritten bool
+}
+
+func (w *csvWriter) Write(e *enemy.Enemy) error {
+	if !w.headerWritten {
+		if err := w.w.Write(csvHeader); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	var deletedAt string
+	if e.GetDeletedAt() != nil {
+		deletedAt = e.GetDeletedAt().AsTime().Format(time.RFC3339Nano)
+	}
+	return w.w.Write([]string{
+		e.GetId(),
+		e.GetName(),
+		e.GetEmail(),
+		strconv.FormatFloat(float64(e.GetRating()), 'g', -1, 32),
+		e.GetLastUpdated().AsTime().Format(time.RFC3339Nano),
+		deletedAt,
+	})
+}
+
+func (w *csvWriter) Flush() error {
+	if !w.headerWritten {
+		// An empty export is still a valid file.
+		if err := w.w.Write(csvHeader); err != nil {
+			return err
+		}
+		w.headerWritten = true
+	}
+	w.w.Flush()
+	return w.w.Error()
+}
+
+type ndjsonWriter struct {
+	w *bufio.Writer
+}
+
+func (w *ndjsonWriter) Write(e *enemy.Enemy) error {
+	b, err := protojson.Marshal(e)
+	if err != nil {
+		return err
+	}
+	// protojson output isn't stable, compacting it keeps one enemy per line.
+	var line bytes.Buffer
+	if err := json.Compact(&line, b); err != nil {
+		return err
+	}
+	line.WriteByte('\n')
+	_, err = w.w.Write(line.Bytes())
+	return err
+}
+
+func (w *ndjsonWriter) Flush() error {
+	return w.w.Flush()
+}
+
+// Row is an enemy read from a file.
+type Row struct {
+	// Line the row starts on, counting from 1.
+	Line   int
+	Name   string
+	Email  string
+	Rating float32
+	// Set if the row is of a deleted enemy, as exported with deleted enemies
+	// shown.
+	Deleted bool
+}
+
+type Reader interface {
+	// Read returns the next row, or io.EOF when there are no more. Rows that
+	// can't be parsed give a *LineError, after which reading can go on.
+	Read() (Row, error)
+}
+
+// NewReader returns a Reader reading enemies in the given format from r.
+func NewReader(r io.Reader, format enemy.Format) (Reader, error) {
+	switch format {
+	case enemy.Format_FORMAT_UNSPECIFIED, enemy.Format_CSV:
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = -1
+		cr.TrimLeadingSpace = true
+		return &csvReader{r: cr}, nil
+	case enemy.Format_NDJSON:
+		s := bufio.NewScanner(r)
+		s.Buffer(nil, maxLineLength)
+		return &ndjsonReader{s: s}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %v", format)
+	}
+}
+
+type csvReader struct {
+	r *csv.Reader
+	// Index of the name, email and rating columns.
+	name, email, rating int
+	// Index of the deletedAt column, or -1 if there is none.
+	deletedAt  int
+	headerRead bool
+}
+
+func (r *csvReader) readHeader() error {
+	header, err := r.r.Read()
+	if err == io.EOF {
+		return err
+	}
+	if err != nil {
+		return csvError(err)
+	}
+	columns := map[string]int{}
+	for i, column := range header {
+		columns[strings.TrimSpace(column)] = i
+	}
+	for _, column := range []struct {
+		name string
+		i    *int
+	}{{"name", &r.name}, {"email", &r.email}, {"rating", &r.rating}} {
+		i, ok := columns[column.name]
+		if !ok {
+			return fmt.Errorf("header is missing the %s column", column.name)
+		}
+		*column.i = i
+	}
+	r.deletedAt = -1
+	if i, ok := columns["deletedAt"]; ok {
+		r.deletedAt = i
+	}
+	r.headerRead = true
+	return nil
+}
+
+func (r *csvReader) Read() (Row, error) {
+	if !r.headerRead {
+		if err := r.readHeader(); err != nil {
+			return Row{}, err
+		}
+	}
+	record, err := r.r.Read()
+	if err != nil {
+		return Row{}, csvError(err)
+	}
+	line, _ := r.r.FieldPos(0)
+	for _, i := range []int{r.name, r.email, r.rating} {
+		if i >= len(record) {
+			return Row{}, &LineError{Line: line, Msg: fmt.Sprintf("expected at least %d columns, got %d", i+1, len(record))}
+		}
+	}
+	row := Row{Line: line, Name: record[r.name], Email: record[r.email]}
+	if r.deletedAt >= 0 && r.deletedAt < len(record) {
+		row.Deleted = strings.TrimSpace(record[r.deletedAt]) != ""
+	}
+	if record[r.rating] != "" {
+		rating, err := strconv.ParseFloat(strings.TrimSpace(record[r.rating]), 32)
+		if err != nil {
+			return Row{}, &LineError{Line: line, Msg: fmt.Sprintf("invalid rating %q", record[r.rating])}
+		}
+		row.Rating = float32(rating)
+	}
+	return row, nil
+}
+
+// csvError turns syntax errors into line errors.
+func csvError(err error) error {
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return &LineError{Line: parseErr.StartLine, Msg: parseErr.Err.Error()}
+	}
+	return err
+}
+
+type ndjsonReader struct {
+	s    *bufio.Scanner
+	line int
+}
+
+func (r *ndjsonReader) Read() (Row, error) {
+	for r.s.Scan() {
+		r.line++
+		if len(bytes.TrimSpace(r.s.Bytes())) == 0 {
+			continue
+		}
+		var e enemy.Enemy
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(r.s.Bytes(), &e); err != nil {
+			return Row{}, &LineError{Line: r.line, Msg: "invalid JSON"}
+		}
+		return Row{Line: r.line, Name: e.GetName(), Email: e.GetEmail(), Rating: e.GetRating(), Deleted: e.GetDeletedAt() != nil}, nil
+	}
+	if err := r.s.Err(); err != nil {
+		return Row{}, err
+	}
+	return Row{}, io.EOF
+}
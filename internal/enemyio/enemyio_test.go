@@ -0,0 +1,157 @@
+package enemyio
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var enemies = []*enemy.Enemy{
+	{
+		Id:          "enemy1",
+		Name:        "Lord Voldemort",
+		Email:       "voldemort@bar.com",
+		Rating:      9.9,
+		LastUpdated: timestamppb.New(time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC)),
+	},
+	{
+		Id:          "enemy2",
+		Name:        "Malfoy, Draco",
+		Email:       "draco@bar.com",
+		Rating:      5.5,
+		LastUpdated: timestamppb.New(time.Date(2021, time.December, 31, 10, 0, 0, 0, time.UTC)),
+		DeletedAt:   timestamppb.New(time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	},
+}
+
+func TestWriter(t *testing.T) {
+	tests := []struct {
+		name   string
+		format enemy.Format
+		give   []*enemy.Enemy
+		want   string
+	}{
+		{
+			name:   "Test CSV",
+			format: enemy.Format_CSV,
+			give:   enemies,
+			want: "id,name,email,rating,lastUpdated,deletedAt\n" +
+				"enemy1,Lord Voldemort,voldemort@bar.com,9.9,2021-12-30T14:59:45Z,\n" +
+				"enemy2,\"Malfoy, Draco\",draco@bar.com,5.5,2021-12-31T10:00:00Z,2022-01-01T00:00:00Z\n",
+		},
+		{
+			name:   "Test empty CSV",
+			format: enemy.Format_CSV,
+			want:   "id,name,email,rating,lastUpdated,deletedAt\n",
+		},
+		{
+			name:   "Test NDJSON",
+			format: enemy.Format_NDJSON,
+			give:   enemies[:1],
+			want:   `{"id":"enemy1","name":"Lord Voldemort","email":"voldemort@bar.com","rating":9.9,"lastUpdated":"2021-12-30T14:59:45Z"}` + "\n",
+		},
+	}
+
+	for _, test := range tests {
+		var buf bytes.Buffer
+		w, err := NewWriter(&buf, test.format)
+		assert.NoError(t, err, test.name)
+		for _, e := range test.give {
+			assert.NoError(t, w.Write(e), test.name)
+		}
+		assert.NoError(t, w.Flush(), test.name)
+		assert.Equal(t, test.want, buf.String(), test.name)
+	}
+}
+
+func TestReader(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  enemy.Format
+		give    string
+		want    []Row
+		wantErr []error
+	}{
+		{
+			name:   "Test CSV",
+			format: enemy.Format_CSV,
+			give: "email, rating, name\n" +
+				"voldemort@bar.com,9.9,Lord Voldemort\n" +
+				"draco@bar.com,lots,Draco\n" +
+				"harry@bar.com\n" +
+				"bellatrix@bar.com,8,\"Bellatrix\n",
+			want: []Row{
+				{Line: 2, Name: "Lord Voldemort", Email: "voldemort@bar.com", Rating: 9.9},
+			},
+			wantErr: []error{
+				&LineError{Line: 3, Msg: `invalid rating "lots"`},
+				&LineError{Line: 4, Msg: "expected at least 3 columns, got 1"},
+				&LineError{Line: 5, Msg: `extraneous or missing " in quoted-field`},
+			},
+		},
+		{
+			name:   "Test CSV with deleted enemies",
+			format: enemy.Format_CSV,
+			give: "id,name,email,rating,lastUpdated,deletedAt\n" +
+				"enemy1,Lord Voldemort,voldemort@bar.com,9.9,2021-12-30T14:59:45Z,\n" +
+				"enemy2,Draco,draco@bar.com,5.5,2021-12-31T10:00:00Z,2022-01-01T00:00:00Z\n",
+			want: []Row{
+				{Line: 2, Name: "Lord Voldemort", Email: "voldemort@bar.com", Rating: 9.9},
+				{Line: 3, Name: "Draco", Email: "draco@bar.com", Rating: 5.5, Deleted: true},
+			},
+		},
+		{
+			name:    "Test CSV without name column",
+			format:  enemy.Format_CSV,
+			give:    "email,rating\n",
+			wantErr: []error{errors.New("header is missing the name column")},
+		},
+		{
+			name:   "Test NDJSON",
+			format: enemy.Format_NDJSON,
+			give: `{"name":"Lord Voldemort","email":"voldemort@bar.com","rating":9.9,"unknown":1}` + "\n" +
+				"\n" +
+				"{not json\n" +
+				`{"name":"Draco","email":"draco@bar.com"}` + "\n" +
+				`{"name":"Bellatrix","email":"bellatrix@bar.com","deletedAt":"2022-01-01T00:00:00Z"}`,
+			want: []Row{
+				{Line: 1, Name: "Lord Voldemort", Email: "voldemort@bar.com", Rating: 9.9},
+				{Line: 4, Name: "Draco", Email: "draco@bar.com"},
+				{Line: 5, Name: "Bellatrix", Email: "bellatrix@bar.com", Deleted: true},
+			},
+			wantErr: []error{
+				&LineError{Line: 3, Msg: "invalid JSON"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		r, err := NewReader(strings.NewReader(test.give), test.format)
+		assert.NoError(t, err, test.name)
+		var rows []Row
+		var errs []error
+		for {
+			row, err := r.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				errs = append(errs, err)
+				if _, ok := err.(*LineError); !ok {
+					break
+				}
+				continue
+			}
+			rows = append(rows, row)
+		}
+		assert.Equal(t, test.want, rows, test.name)
+		assert.Equal(t, test.wantErr, errs, test.name)
+	}
+}
@@ -0,0 +1,348 @@
+// Package filter parses AIP-160 style filter expressions over the fields of an
+// Enemy, eg. `rating > 7 AND email = "*@corp.com"`.
+//
+// Supported syntax:
+//
+//	expression  = sequence { "AND" sequence }
+//	sequence    = factor { factor }            (implicit AND)
+//	factor      = term { "OR" term }
+//	term        = [ "NOT" | "-" ] simple
+//	simple      = restriction | "(" expression ")"
+//	restriction = field comparator value
+//	comparator  = "=" | "!=" | "<" | "<=" | ">" | ">=" | ":"
+//
+// As in AIP-160, OR binds tighter than AND. Keywords are case insensitive.
+// String values may be quoted and may use a leading and/or trailing "*" as a
+// wildcard with "=" and "!=". The ":" (has) operator matches a substring,
+// ignoring case. Timestamps are given in RFC 3339 format and must be quoted.
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type Field string
+
+const (
+	FieldName        Field = "name"
+	FieldEmail       Field = "email"
+	FieldRating      Field = "rating"
+	FieldLastUpdated Field = "last_updated"
+)
+
+// Maps the accepted spellings of field names to fields.
+var fields = map[string]Field{
+	"name":         FieldName,
+	"email":        FieldEmail,
+	"rating":       FieldRating,
+	"last_updated": FieldLastUpdated,
+	"lastUpdated":  FieldLastUpdated,
+}
+
+type Operator string
+
+const (
+	OpEqual        Operator = "="
+	OpNotEqual     Operator = "!="
+	OpLess         Operator = "<"
+	OpLessEqual    Operator = "<="
+	OpGreater      Operator = ">"
+	OpGreaterEqual Operator = ">="
+	OpHas          Operator = ":"
+)
+
+// Expr is a node in a parsed filter. It is one of *And, *Or, *Not or
+// *Restriction.
+type Expr interface {
+	isExpr()
+}
+
+type And struct {
+	Left, Right Expr
+}
+
+type Or struct {
+	Left, Right Expr
+}
+
+type Not struct {
+	Expr Expr
+}
+
+// Restriction compares a field with a value. Value is a string for name and
+// email, a float32 for rating and a time.Time for last_updated.
+type Restriction struct {
+	Field Field
+	Op    Operator
+	Value interface{}
+}
+
+func (*And) isExpr()         {}
+func (*Or) isExpr()          {}
+func (*Not) isExpr()         {}
+func (*Restriction) isExpr() {}
+
+// Error describes a syntax or type error in a filter. Pos is the 1-based
+// position in the filter where the error was found.
+type Error struct {
+	Pos int
+	Msg string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s at position %d", e.Msg, e.Pos)
+}
+
+// Parse parses a filter expression. An empty filter gives a nil Expr.
+func Parse(s string) (Expr, error) {
+	toks, err := lex(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	if p.peek().kind == tokEOF {
+		return nil, nil
+	}
+	expr, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, p.unexpected(t)
+	}
+	return expr, nil
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokText
+	tokString
+	tokComparator
+	tokLParen
+	tokRParen
+	tokMinus
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+func lex(s string) ([]token, error) {
+	var toks []token
+	r := []rune(s)
+	for i := 0; i < len(r); {
+		c := r[i]
+		pos := i + 1
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(':
+			toks = append(toks, token{kind: tokLParen, text: "(", pos: pos})
+			i++
+		case c == ')':
+			toks = append(toks, token{kind: tokRParen, text: ")", pos: pos})
+			i++
+		case c == '-' && (i+1 == len(r) || !unicode.IsDigit(r[i+1])):
+			toks = append(toks, token{kind: tokMinus, text: "-", pos: pos})
+			i++
+		case c == '=' || c == ':':
+			toks = append(toks, token{kind: tokComparator, text: string(c), pos: pos})
+			i++
+		case c == '!' || c == '<' || c == '>':
+			if i+1 < len(r) && r[i+1] == '=' {
+				toks = append(toks, token{kind: tokComparator, text: string(r[i : i+2]), pos: pos})
+				i += 2
+				continue
+			}
+			if c == '!' {
+				return nil, &Error{Pos: pos, Msg: `expected "=" after "!"`}
+			}
+			toks = append(toks, token{kind: tokComparator, text: string(c), pos: pos})
+			i++
+		case c == '"':
+			var sb strings.Builder
+			i++
+			for ; i < len(r) && r[i] != '"'; i++ {
+				if r[i] == '\\' && i+1 < len(r) {
+					i++
+				}
+				sb.WriteRune(r[i])
+			}
+			if i == len(r) {
+				return nil, &Error{Pos: pos, Msg: "unterminated string"}
+			}
+			i++
+			toks = append(toks, token{kind: tokString, text: sb.String(), pos: pos})
+		default:
+			start := i
+			for i < len(r) && !unicode.IsSpace(r[i]) && !strings.ContainsRune(`()=!<>:"`, r[i]) {
+				i++
+			}
+			toks = append(toks, token{kind: tokText, text: string(r[start:i]), pos: pos})
+		}
+	}
+	return append(toks, token{kind: tokEOF, pos: len(r) + 1}), nil
+}
+
+type parser struct {
+	toks []token
+	i    int
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.i]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) keyword(t token, kw string) bool {
+	return t.kind == tokText && strings.EqualFold(t.text, kw)
+}
+
+func (p *parser) unexpected(t token) error {
+	if t.kind == tokEOF {
+		return &Error{Pos: t.pos, Msg: "unexpected end of filter"}
+	}
+	return &Error{Pos: t.pos, Msg: fmt.Sprintf("unexpected %q", t.text)}
+}
+
+func (p *parser) expression() (Expr, error) {
+	left, err := p.sequence()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword(p.peek(), "AND") {
+		p.next()
+		right, err := p.sequence()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) sequence() (Expr, error) {
+	left, err := p.factor()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind == tokEOF || t.kind == tokRParen || p.keyword(t, "AND") {
+			return left, nil
+		}
+		right, err := p.factor()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+}
+
+func (p *parser) factor() (Expr, error) {
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for p.keyword(p.peek(), "OR") {
+		p.next()
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) term() (Expr, error) {
+	if t := p.peek(); t.kind == tokMinus || p.keyword(t, "NOT") {
+		p.next()
+		expr, err := p.simple()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	}
+	return p.simple()
+}
+
+func (p *parser) simple() (Expr, error) {
+	t := p.next()
+	switch {
+	case t.kind == tokLParen:
+		expr, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if t := p.next(); t.kind != tokRParen {
+			return nil, p.unexpected(t)
+		}
+		return expr, nil
+	case t.kind == tokText && !p.keyword(t, "AND") && !p.keyword(t, "OR") && !p.keyword(t, "NOT"):
+		return p.restriction(t)
+	default:
+		return nil, p.unexpected(t)
+	}
+}
+
+func (p *parser) restriction(fieldTok token) (Expr, error) {
+	field, ok := fields[fieldTok.text]
+	if !ok {
+		return nil, &Error{Pos: fieldTok.pos, Msg: fmt.Sprintf("unknown field %q", fieldTok.text)}
+	}
+	opTok := p.next()
+	if opTok.kind != tokComparator {
+		return nil, p.unexpected(opTok)
+	}
+	op := Operator(opTok.text)
+	valTok := p.next()
+	if valTok.kind != tokText && valTok.kind != tokString {
+		return nil, p.unexpected(valTok)
+	}
+
+	var value interface{}
+	switch field {
+	case FieldName, FieldEmail:
+		switch op {
+		case OpEqual, OpNotEqual, OpHas:
+		default:
+			return nil, &Error{Pos: opTok.pos, Msg: fmt.Sprintf("operator %q not supported for field %q", op, field)}
+		}
+		value = valTok.text
+	case FieldRating:
+		if op == OpHas {
+			return nil, &Error{Pos: opTok.pos, Msg: fmt.Sprintf("operator %q not supported for field %q", op, field)}
+		}
+		f, err := strconv.ParseFloat(valTok.text, 32)
+		if err != nil {
+			return nil, &Error{Pos: valTok.pos, Msg: fmt.Sprintf("invalid number %q", valTok.text)}
+		}
+		value = float32(f)
+	case FieldLastUpdated:
+		if op == OpHas {
+			return nil, &Error{Pos: opTok.pos, Msg: fmt.Sprintf("operator %q not supported for field %q", op, field)}
+		}
+		ts, err := time.Parse(time.RFC3339, valTok.text)
+		if err != nil {
+			return nil, &Error{Pos: valTok.pos, Msg: fmt.Sprintf("invalid timestamp %q", valTok.text)}
+		}
+		value = ts
+	}
+	return &Restriction{Field: field, Op: op, Value: value}, nil
+}
@@ -0,0 +1,97 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    string
+		want    Expr
+		wantErr error
+	}{
+		{
+			name: "Test empty",
+			give: "  ",
+		},
+		{
+			name: "Test single restriction",
+			give: "rating > 7",
+			want: &Restriction{Field: FieldRating, Op: OpGreater, Value: float32(7)},
+		},
+		{
+			name: "Test and with wildcard",
+			give: `rating > 7 and email = "*@corp.com"`,
+			want: &And{
+				Left:  &Restriction{Field: FieldRating, Op: OpGreater, Value: float32(7)},
+				Right: &Restriction{Field: FieldEmail, Op: OpEqual, Value: "*@corp.com"},
+			},
+		},
+		{
+			name: "Test or binds tighter than and",
+			give: "name:voldemort AND rating >= 9.5 OR rating < 1",
+			want: &And{
+				Left: &Restriction{Field: FieldName, Op: OpHas, Value: "voldemort"},
+				Right: &Or{
+					Left:  &Restriction{Field: FieldRating, Op: OpGreaterEqual, Value: float32(9.5)},
+					Right: &Restriction{Field: FieldRating, Op: OpLess, Value: float32(1)},
+				},
+			},
+		},
+		{
+			name: "Test implicit and, not and parentheses",
+			give: `NOT (name = "Lord Voldemort") -email != x lastUpdated <= "2021-12-31T14:59:05Z"`,
+			want: &And{
+				Left: &And{
+					Left:  &Not{Expr: &Restriction{Field: FieldName, Op: OpEqual, Value: "Lord Voldemort"}},
+					Right: &Not{Expr: &Restriction{Field: FieldEmail, Op: OpNotEqual, Value: "x"}},
+				},
+				Right: &Restriction{Field: FieldLastUpdated, Op: OpLessEqual, Value: time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC)},
+			},
+		},
+		{
+			name:    "Test unknown field",
+			give:    "rating > 7 AND age > 3",
+			wantErr: &Error{Pos: 16, Msg: `unknown field "age"`},
+		},
+		{
+			name:    "Test invalid number",
+			give:    "rating > seven",
+			wantErr: &Error{Pos: 10, Msg: `invalid number "seven"`},
+		},
+		{
+			name:    "Test unsupported operator",
+			give:    "name > a",
+			wantErr: &Error{Pos: 6, Msg: `operator ">" not supported for field "name"`},
+		},
+		{
+			name:    "Test missing closing parenthesis",
+			give:    "(rating > 7",
+			wantErr: &Error{Pos: 12, Msg: "unexpected end of filter"},
+		},
+		{
+			name:    "Test unterminated string",
+			give:    `name = "Voldemort`,
+			wantErr: &Error{Pos: 8, Msg: "unterminated string"},
+		},
+		{
+			name:    "Test dangling operator",
+			give:    "rating > 7 AND",
+			wantErr: &Error{Pos: 15, Msg: "unexpected end of filter"},
+		},
+	}
+
+	for _, test := range tests {
+		res, err := Parse(test.give)
+		assert.Equal(t, test.want, res, test.name)
+		if test.wantErr == nil {
+			assert.NoError(t, err, test.name)
+		} else {
+			assert.Equal(t, test.wantErr, err, test.name)
+		}
+	}
+}
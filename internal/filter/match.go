@@ -0,0 +1,98 @@
+package filter
+
+import (
+	"strings"
+	"time"
+)
+
+// Match reports whether the values returned by get satisfy expr, with the same
+// semantics as the filter has in storage. get must return values of the types
+// documented on Restriction. A nil expr matches everything.
+func Match(expr Expr, get func(Field) interface{}) bool {
+	switch e := expr.(type) {
+	case nil:
+		return true
+	case *And:
+		return Match(e.Left, get) && Match(e.Right, get)
+	case *Or:
+		return Match(e.Left, get) || Match(e.Right, get)
+	case *Not:
+		return !Match(e.Expr, get)
+	case *Restriction:
+		return e.match(get(e.Field))
+	default:
+		return false
+	}
+}
+
+func (r *Restriction) match(v interface{}) bool {
+	var cmp int
+	switch v := v.(type) {
+	case string:
+		want, _ := r.Value.(string)
+		switch r.Op {
+		case OpHas:
+			return strings.Contains(strings.ToLower(v), strings.ToLower(want))
+		case OpEqual, OpNotEqual:
+			if strings.HasPrefix(want, "*") || strings.HasSuffix(want, "*") {
+				return matchWildcard(v, want) == (r.Op == OpEqual)
+			}
+		}
+		cmp = strings.Compare(v, want)
+	case float32:
+		want, _ := r.Value.(float32)
+		switch {
+		case v < want:
+			cmp = -1
+		case v > want:
+			cmp = 1
+		}
+	case time.Time:
+		want, _ := r.Value.(time.Time)
+		switch {
+		case v.Before(want):
+			cmp = -1
+		case v.After(want):
+			cmp = 1
+		}
+	default:
+		return false
+	}
+	switch r.Op {
+	case OpEqual:
+		return cmp == 0
+	case OpNotEqual:
+		return cmp != 0
+	case OpLess:
+		return cmp < 0
+	case OpLessEqual:
+		return cmp <= 0
+	case OpGreater:
+		return cmp > 0
+	case OpGreaterEqual:
+		return cmp >= 0
+	default:
+		return false
+	}
+}
+
+// matchWildcard matches s against a pattern with a leading and/or trailing
+// "*".
+func matchWildcard(s, pattern string) bool {
+	prefix := strings.HasPrefix(pattern, "*")
+	if prefix {
+		pattern = pattern[1:]
+	}
+	suffix := strings.HasSuffix(pattern, "*")
+	if suffix {
+		pattern = pattern[:len(pattern)-1]
+	}
+	switch {
+	case prefix && suffix:
+		return strings.Contains(s, pattern)
+	case prefix:
+		return strings.HasSuffix(s, pattern)
+	default:
+		return strings.HasPrefix(s, pattern)
+	}
+}
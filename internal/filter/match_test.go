@@ -0,0 +1,43 @@
+package filter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	values := map[Field]interface{}{
+		FieldName:        "Lord Voldemort",
+		FieldEmail:       "voldemort@corp.com",
+		FieldRating:      float32(9.5),
+		FieldLastUpdated: time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC),
+	}
+	get := func(f Field) interface{} { return values[f] }
+
+	tests := []struct {
+		give string
+		want bool
+	}{
+		{give: "", want: true},
+		{give: "rating > 7", want: true},
+		{give: "rating >= 9.5 AND rating < 9.6", want: true},
+		{give: "rating = 1 OR rating = 2", want: false},
+		{give: `email = "*@corp.com"`, want: true},
+		{give: `email != "*@corp.com"`, want: false},
+		{give: `email = "voldemort*"`, want: true},
+		{give: `name = "*Volde*"`, want: true},
+		{give: `name = "lord voldemort"`, want: false},
+		{give: "name:VOLDE", want: true},
+		{give: "-name:harry", want: true},
+		{give: `lastUpdated < "2022-01-01T00:00:00Z"`, want: true},
+		{give: `lastUpdated > "2022-01-01T00:00:00Z"`, want: false},
+	}
+
+	for _, test := range tests {
+		expr, err := Parse(test.give)
+		assert.NoError(t, err, test.give)
+		assert.Equal(t, test.want, Match(expr, get), test.give)
+	}
+}
@@ -0,0 +1,55 @@
+// Package orderby parses AIP-132 style order_by strings, eg.
+// "rating desc, name".
+package orderby
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Term struct {
+	Field string
+	Desc  bool
+}
+
+// Parse splits an order_by string into terms. It only checks the syntax, it is
+// up to the caller to decide which fields may be ordered by.
+func Parse(s string) ([]Term, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var terms []Term
+	for i, part := range strings.Split(s, ",") {
+		words := strings.Fields(part)
+		switch {
+		case len(words) == 0:
+			return nil, fmt.Errorf("term %d is empty", i+1)
+		case len(words) > 2:
+			return nil, fmt.Errorf("term %d has too many words: %q", i+1, strings.TrimSpace(part))
+		}
+		term := Term{Field: words[0]}
+		if len(words) == 2 {
+			switch strings.ToLower(words[1]) {
+			case "asc":
+			case "desc":
+				term.Desc = true
+			default:
+				return nil, fmt.Errorf("term %d has invalid direction %q", i+1, words[1])
+			}
+		}
+		terms = append(terms, term)
+	}
+	return terms, nil
+}
+
+// String formats terms the way they are accepted by Parse.
+func String(terms []Term) string {
+	parts := make([]string, len(terms))
+	for i, t := range terms {
+		parts[i] = t.Field
+		if t.Desc {
+			parts[i] += " desc"
+		}
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,52 @@
+package orderby
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    string
+		want    []Term
+		wantErr error
+	}{
+		{
+			name: "Test empty",
+			give: "",
+		},
+		{
+			name: "Test multiple terms",
+			give: "rating desc, name,email ASC",
+			want: []Term{{Field: "rating", Desc: true}, {Field: "name"}, {Field: "email"}},
+		},
+		{
+			name:    "Test empty term",
+			give:    "rating,,name",
+			wantErr: errors.New("term 2 is empty"),
+		},
+		{
+			name:    "Test invalid direction",
+			give:    "rating down",
+			wantErr: errors.New(`term 1 has invalid direction "down"`),
+		},
+		{
+			name:    "Test too many words",
+			give:    "rating desc name",
+			wantErr: errors.New(`term 1 has too many words: "rating desc name"`),
+		},
+	}
+
+	for _, test := range tests {
+		res, err := Parse(test.give)
+		assert.Equal(t, test.want, res, test.name)
+		assert.Equal(t, test.wantErr, err, test.name)
+	}
+}
+
+func TestString(t *testing.T) {
+	assert.Equal(t, "rating desc, name", String([]Term{{Field: "rating", Desc: true}, {Field: "name"}}))
+}
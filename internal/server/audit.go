@@ -0,0 +1,46 @@
+package server
+
+import (
+	"context"
+
+	"github.com/larwef/rpi-docker-test/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Metadata key clients use to say who they are, for the audit log.
+const actorHeader = "actor"
+
+// AuditUnaryInterceptor records the caller of each unary RPC in the context,
+// so storage can write it to the audit log.
+func AuditUnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(withAuditInfo(ctx, info.FullMethod), req)
+}
+
+// AuditStreamInterceptor is AuditUnaryInterceptor for streaming RPCs.
+func AuditStreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &auditStream{ServerStream: ss, ctx: withAuditInfo(ss.Context(), info.FullMethod)})
+}
+
+type auditStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *auditStream) Context() context.Context {
+	return s.ctx
+}
+
+func withAuditInfo(ctx context.Context, method string) context.Context {
+	info := storage.AuditInfo{Method: method}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if actors := md.Get(actorHeader); len(actors) > 0 {
+			info.Actor = actors[0]
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		info.Peer = p.Addr.String()
+	}
+	return storage.WithAuditInfo(ctx, info)
+}
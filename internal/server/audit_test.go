@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/larwef/rpi-docker-test/internal/storage"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func TestAuditUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		peer *peer.Peer
+		want storage.AuditInfo
+	}{
+		{
+			name: "Test no metadata",
+			want: storage.AuditInfo{Method: "/enemy.EnemyService/AddEnemy"},
+		},
+		{
+			name: "Test actor and peer",
+			md:   metadata.Pairs("actor", "harry"),
+			peer: &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 4711}},
+			want: storage.AuditInfo{
+				Actor:  "harry",
+				Method: "/enemy.EnemyService/AddEnemy",
+				Peer:   "10.0.0.1:4711",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		ctx := context.Background()
+		if test.md != nil {
+			ctx = metadata.NewIncomingContext(ctx, test.md)
+		}
+		if test.peer != nil {
+			ctx = peer.NewContext(ctx, test.peer)
+		}
+		var got storage.AuditInfo
+		_, err := AuditUnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/enemy.EnemyService/AddEnemy"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			got, _ = storage.AuditInfoFrom(ctx)
+			return nil, nil
+		})
+		assert.NoError(t, err, test.name)
+		assert.Equal(t, test.want, got, test.name)
+	}
+}
@@ -0,0 +1,136 @@
+package server
+
+import (
+	"context"
+	"io"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Largest number of enemies accepted in one batch, unless set with
+// WithMaxBatchSize.
+const defaultMaxBatchSize = 1000
+
+func (s *Server) BatchAddEnemies(stream enemy.EnemyService_BatchAddEnemiesServer) error {
+	var atomic bool
+	var results []*enemy.BatchAddEnemyResult
+	// The valid requests, and where their results go.
+	var reqs []*enemy.AddEnemyRequest
+	var indexes []int
+	for i := 0; ; i++ {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			atomic = req.GetAtomic()
+		}
+		if i == s.maxBatchSize {
+			return s.batchTooLarge()
+		}
+		err = validateAddEnemy(req.GetEnemy())
+		if err == nil {
+			err = validateIdempotencyKey(req.GetEnemy().GetIdempotencyKey())
+		}
+		if err != nil {
+			if atomic {
+				return status.Errorf(codes.InvalidArgument, "enemy %d: %s", i, status.Convert(err).Message())
+			}
+			results = append(results, errorResult(err))
+			continue
+		}
+		results = append(results, nil)
+		reqs = append(reqs, req.GetEnemy())
+		indexes = append(indexes, i)
+	}
+
+	if len(reqs) > 0 {
+		res, err := s.storage.BatchAddEnemies(stream.Context(), reqs, atomic)
+		if err != nil {
+			return toStatus(err)
+		}
+		for j, r := range res {
+			if r.Err != nil {
+				results[indexes[j]] = errorResult(toStatus(r.Err))
+				continue
+			}
+			results[indexes[j]] = &enemy.BatchAddEnemyResult{Enemy: r.Enemy}
+			if !r.Replayed {
+				s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_ADDED, Enemy: r.Enemy})
+			}
+		}
+	}
+	return stream.SendAndClose(&enemy.BatchAddEnemiesResponse{Results: results})
+}
+
+// errorResult is the result of a batch item that failed with the status
+// error err.
+func errorResult(err error) *enemy.BatchAddEnemyResult {
+	st := status.Convert(err)
+	return &enemy.BatchAddEnemyResult{
+		ErrorCode:    int32(st.Code()),
+		ErrorMessage: st.Message(),
+	}
+}
+
+func (s *Server) BatchGetEnemies(ctx context.Context, req *enemy.BatchGetEnemiesRequest) (*enemy.BatchGetEnemiesResponse, error) {
+	if len(req.GetIds()) > s.maxBatchSize {
+		return nil, s.batchTooLarge()
+	}
+	for i, id := range req.GetIds() {
+		if id == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "enemy %d: id can't be empty", i)
+		}
+	}
+	res, err := s.storage.BatchGetEnemies(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (s *Server) BatchUpdateEnemies(ctx context.Context, req *enemy.BatchUpdateEnemiesRequest) (*enemy.BatchUpdateEnemiesResponse, error) {
+	if len(req.GetRequests()) > s.maxBatchSize {
+		return nil, s.batchTooLarge()
+	}
+	for i, updateReq := range req.GetRequests() {
+		if updateReq.GetId() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "enemy %d: id can't be empty", i)
+		}
+		tags, err := normalizeTags(updateReq.GetTags())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "enemy %d: %s", i, status.Convert(err).Message())
+		}
+		updateReq.Tags = tags
+	}
+	res, err := s.storage.BatchUpdateEnemies(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	for _, enmy := range res.GetEnemies() {
+		s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: enmy})
+	}
+	return res, nil
+}
+
+// BulkLoadEnemies isn't limited by the max batch size, as the rows are
+// streamed to the database instead of being held in memory.
+func (s *Server) BulkLoadEnemies(stream enemy.EnemyService_BulkLoadEnemiesServer) error {
+	res, events, err := s.storage.BulkLoadEnemies(stream.Context(), stream.Recv)
+	if err != nil {
+		return toStatus(err)
+	}
+	for _, ev := range events {
+		s.events.publish(ev)
+	}
+	return stream.SendAndClose(res)
+}
+
+func (s *Server) batchTooLarge() error {
+	return status.Errorf(codes.InvalidArgument, "batch can't have more than %d enemies", s.maxBatchSize)
+}
@@ -0,0 +1,295 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/larwef/rpi-docker-test/internal/storage"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	gotestAssert "gotest.tools/v3/assert"
+)
+
+// batchAddStream replays reqs and keeps the response.
+type batchAddStream struct {
+	grpc.ServerStream
+	reqs []*enemy.BatchAddEnemiesRequest
+	res  *enemy.BatchAddEnemiesResponse
+}
+
+func (s *batchAddStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *batchAddStream) Recv() (*enemy.BatchAddEnemiesRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *batchAddStream) SendAndClose(res *enemy.BatchAddEnemiesResponse) error {
+	s.res = res
+	return nil
+}
+
+func TestServer_BatchAddEnemies(t *testing.T) {
+	voldemort := &enemy.AddEnemyRequest{Name: "Voldemort", Email: "voldemort@bar.com", Rating: 9.9}
+	draco := &enemy.AddEnemyRequest{Name: "Draco", Email: "draco@bar.com", Rating: 5.5}
+	storageMock := &storageMock{
+		batchAddEnemies: func(ctx context.Context, reqs []*enemy.AddEnemyRequest, atomic bool) ([]storage.BatchResult, error) {
+			var res []storage.BatchResult
+			for _, req := range reqs {
+				if req.GetEmail() == "draco@bar.com" {
+					res = append(res, storage.BatchResult{Err: &storage.Error{Kind: storage.ErrConflict, Msg: "enemy already exists"}})
+					continue
+				}
+				res = append(res, storage.BatchResult{Enemy: &enemy.Enemy{Id: "enemy1", Name: req.GetName()}})
+			}
+			return res, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		give    []*enemy.BatchAddEnemiesRequest
+		want    *enemy.BatchAddEnemiesResponse
+		wantErr error
+	}{
+		{
+			name: "Test per item results",
+			give: []*enemy.BatchAddEnemiesRequest{
+				{Enemy: voldemort},
+				{Enemy: &enemy.AddEnemyRequest{Name: "Nameless"}},
+				{Enemy: draco},
+			},
+			want: &enemy.BatchAddEnemiesResponse{
+				Results: []*enemy.BatchAddEnemyResult{
+					{Enemy: &enemy.Enemy{Id: "enemy1", Name: "Voldemort"}},
+					{ErrorCode: int32(codes.InvalidArgument), ErrorMessage: "enemy email can't be empty"},
+					{ErrorCode: int32(codes.AlreadyExists), ErrorMessage: "enemy already exists"},
+				},
+			},
+		},
+		{
+			name: "Test atomic with invalid enemy",
+			give: []*enemy.BatchAddEnemiesRequest{
+				{Enemy: voldemort, Atomic: true},
+				{Enemy: &enemy.AddEnemyRequest{Name: "Keyed", Email: "keyed@bar.com", Rating: 1, IdempotencyKey: strings.Repeat("k", 129)}},
+			},
+			wantErr: status.Error(codes.InvalidArgument, "enemy 1: idempotency key can't be longer than 128 characters"),
+		},
+		{
+			name: "Test empty batch",
+			want: &enemy.BatchAddEnemiesResponse{},
+		},
+	}
+
+	for _, test := range tests {
+		srv := New(storageMock)
+		stream := &batchAddStream{reqs: test.give}
+		err := srv.BatchAddEnemies(stream)
+		assert.Equal(t, test.wantErr, err, test.name)
+		gotestAssert.DeepEqual(t, test.want, stream.res, protocmp.Transform())
+	}
+}
+
+func TestServer_BatchAddEnemies_IdempotencyKeys(t *testing.T) {
+	srv := New(&storageMock{
+		batchAddEnemies: func(ctx context.Context, reqs []*enemy.AddEnemyRequest, atomic bool) ([]storage.BatchResult, error) {
+			var res []storage.BatchResult
+			for _, req := range reqs {
+				// Keyed requests have been seen before.
+				res = append(res, storage.BatchResult{
+					Enemy:    &enemy.Enemy{Id: req.GetEmail(), Name: req.GetName()},
+					Replayed: req.GetIdempotencyKey() != "",
+				})
+			}
+			return res, nil
+		},
+	})
+	stream := &batchAddStream{reqs: []*enemy.BatchAddEnemiesRequest{
+		{Enemy: &enemy.AddEnemyRequest{Name: "Voldemort", Email: "voldemort@bar.com", Rating: 9.9, IdempotencyKey: "someKey"}},
+		{Enemy: &enemy.AddEnemyRequest{Name: "Draco", Email: "draco@bar.com", Rating: 5.5}},
+	}}
+	assert.NoError(t, srv.BatchAddEnemies(stream))
+	gotestAssert.DeepEqual(t, &enemy.BatchAddEnemiesResponse{
+		Results: []*enemy.BatchAddEnemyResult{
+			{Enemy: &enemy.Enemy{Id: "voldemort@bar.com", Name: "Voldemort"}},
+			{Enemy: &enemy.Enemy{Id: "draco@bar.com", Name: "Draco"}},
+		},
+	}, stream.res, protocmp.Transform())
+
+	// The replayed enemy was published when it was added.
+	assert.Len(t, srv.events.history, 1)
+	assert.Equal(t, "Draco", srv.events.history[0].GetEnemy().GetName())
+}
+
+func TestServer_BatchGetEnemies(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.BatchGetEnemiesRequest
+		opts    []Option
+		storage *storageMock
+		want    *enemy.BatchGetEnemiesResponse
+		wantErr error
+	}{
+		{
+			name: "Test error from storage",
+			give: &enemy.BatchGetEnemiesRequest{Ids: []string{"enemy1"}},
+			storage: &storageMock{
+				batchGetEnemies: func(ctx context.Context, req *enemy.BatchGetEnemiesRequest) (*enemy.BatchGetEnemiesResponse, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			wantErr: status.Error(codes.Internal, "internal error"),
+		},
+		{
+			name:    "Test empty id",
+			give:    &enemy.BatchGetEnemiesRequest{Ids: []string{"enemy1", ""}},
+			wantErr: status.Error(codes.InvalidArgument, "enemy 1: id can't be empty"),
+		},
+		{
+			name:    "Test too many ids",
+			give:    &enemy.BatchGetEnemiesRequest{Ids: []string{"enemy1", "enemy2", "enemy3"}},
+			opts:    []Option{WithMaxBatchSize(2)},
+			wantErr: status.Error(codes.InvalidArgument, "batch can't have more than 2 enemies"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.BatchGetEnemiesRequest{Ids: []string{"enemy2", "missing", "enemy1"}},
+			storage: &storageMock{
+				batchGetEnemies: func(ctx context.Context, req *enemy.BatchGetEnemiesRequest) (*enemy.BatchGetEnemiesResponse, error) {
+					return &enemy.BatchGetEnemiesResponse{
+						Enemies:    []*enemy.Enemy{{Id: "enemy2"}, {Id: "enemy1"}},
+						MissingIds: []string{"missing"},
+					}, nil
+				},
+			},
+			want: &enemy.BatchGetEnemiesResponse{
+				Enemies:    []*enemy.Enemy{{Id: "enemy2"}, {Id: "enemy1"}},
+				MissingIds: []string{"missing"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		srv := New(test.storage, test.opts...)
+		res, err := srv.BatchGetEnemies(context.Background(), test.give)
+		gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		assert.Equal(t, test.wantErr, err, test.name)
+	}
+}
+
+func TestServer_BatchUpdateEnemies(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.BatchUpdateEnemiesRequest
+		storage *storageMock
+		want    *enemy.BatchUpdateEnemiesResponse
+		wantErr error
+	}{
+		{
+			name: "Test error from storage",
+			give: &enemy.BatchUpdateEnemiesRequest{Requests: []*enemy.UpdateEnemyRequest{{Id: "enemy1"}}},
+			storage: &storageMock{
+				batchUpdateEnemies: func(ctx context.Context, req *enemy.BatchUpdateEnemiesRequest) (*enemy.BatchUpdateEnemiesResponse, error) {
+					return nil, &storage.Error{Kind: storage.ErrNotFound, Msg: "enemy 0: enemy not found"}
+				},
+			},
+			wantErr: status.Error(codes.NotFound, "enemy 0: enemy not found"),
+		},
+		{
+			name:    "Test empty id",
+			give:    &enemy.BatchUpdateEnemiesRequest{Requests: []*enemy.UpdateEnemyRequest{{}}},
+			wantErr: status.Error(codes.InvalidArgument, "enemy 0: id can't be empty"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.BatchUpdateEnemiesRequest{Requests: []*enemy.UpdateEnemyRequest{{Id: "enemy1", Rating: 2.2}}},
+			storage: &storageMock{
+				batchUpdateEnemies: func(ctx context.Context, req *enemy.BatchUpdateEnemiesRequest) (*enemy.BatchUpdateEnemiesResponse, error) {
+					return &enemy.BatchUpdateEnemiesResponse{Enemies: []*enemy.Enemy{{Id: "enemy1", Rating: 2.2}}}, nil
+				},
+			},
+			want: &enemy.BatchUpdateEnemiesResponse{Enemies: []*enemy.Enemy{{Id: "enemy1", Rating: 2.2}}},
+		},
+	}
+
+	for _, test := range tests {
+		srv := New(test.storage)
+		res, err := srv.BatchUpdateEnemies(context.Background(), test.give)
+		gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		assert.Equal(t, test.wantErr, err, test.name)
+	}
+}
+
+// bulkLoadStream replays reqs and keeps the response.
+type bulkLoadStream struct {
+	grpc.ServerStream
+	reqs []*enemy.BulkLoadEnemiesRequest
+	res  *enemy.BulkLoadEnemiesResponse
+}
+
+func (s *bulkLoadStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *bulkLoadStream) Recv() (*enemy.BulkLoadEnemiesRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *bulkLoadStream) SendAndClose(res *enemy.BulkLoadEnemiesResponse) error {
+	s.res = res
+	return nil
+}
+
+func TestServer_BulkLoadEnemies(t *testing.T) {
+	srv := New(&storageMock{
+		bulkLoadEnemies: func(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, []*enemy.EnemyEvent, error) {
+			res := &enemy.BulkLoadEnemiesResponse{}
+			var events []*enemy.EnemyEvent
+			for {
+				row, err := next()
+				if err == io.EOF {
+					return res, events, nil
+				}
+				if err != nil {
+					return nil, nil, err
+				}
+				res.Inserted++
+				events = append(events, &enemy.EnemyEvent{Type: enemy.EnemyEvent_ADDED, Enemy: &enemy.Enemy{Name: row.GetName()}})
+			}
+		},
+	})
+	stream := &bulkLoadStream{
+		reqs: []*enemy.BulkLoadEnemiesRequest{
+			{Name: "Voldemort", Email: "voldemort@bar.com", Rating: 9.9},
+			{Name: "Draco", Email: "draco@bar.com", Rating: 5.5},
+		},
+	}
+	err := srv.BulkLoadEnemies(stream)
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.BulkLoadEnemiesResponse{Inserted: 2}, stream.res, protocmp.Transform())
+
+	// Watchers see the loaded enemies like any other change.
+	assert.Len(t, srv.events.history, 2)
+	for i, name := range []string{"Voldemort", "Draco"} {
+		assert.Equal(t, enemy.EnemyEvent_ADDED, srv.events.history[i].GetType())
+		assert.Equal(t, name, srv.events.history[i].GetEnemy().GetName())
+	}
+}
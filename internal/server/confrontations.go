@@ -0,0 +1,73 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Longest confrontation notes accepted, in bytes.
+const maxNotesLength = 4096
+
+// Metadata with the admin token, as "Bearer <token>".
+const authorizationHeader = "authorization"
+
+func (s *Server) RecordConfrontation(ctx context.Context, req *enemy.RecordConfrontationRequest) (*enemy.RecordConfrontationResponse, error) {
+	_, knownOutcome := enemy.Confrontation_Outcome_name[int32(req.GetOutcome())]
+	switch {
+	case req.GetEnemyId() == "":
+		return nil, status.Error(codes.InvalidArgument, "enemy id can't be empty")
+	case req.GetOutcome() == enemy.Confrontation_OUTCOME_UNSPECIFIED:
+		return nil, status.Error(codes.InvalidArgument, "outcome must be set")
+	case !knownOutcome:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown outcome %d", req.GetOutcome())
+	case len(req.GetNotes()) > maxNotesLength:
+		return nil, status.Errorf(codes.InvalidArgument, "notes can't be longer than %d bytes", maxNotesLength)
+	case req.GetOccurredAt() != nil && req.GetOccurredAt().AsTime().After(time.Now()):
+		return nil, status.Error(codes.InvalidArgument, "occurred at can't be in the future")
+	}
+	res, err := s.storage.RecordConfrontation(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: res.GetEnemy()})
+	return res, nil
+}
+
+func (s *Server) RecomputeRatings(ctx context.Context, req *enemy.RecomputeRatingsRequest) (*enemy.RecomputeRatingsResponse, error) {
+	if err := s.requireAdmin(ctx, "RecomputeRatings"); err != nil {
+		return nil, err
+	}
+	res, updated, err := s.storage.RecomputeRatings(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	for _, enmy := range updated {
+		s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: enmy})
+	}
+	return res, nil
+}
+
+// requireAdmin checks that the caller sent the admin token. The actor metadata
+// isn't enough, as any caller can claim to be anyone.
+func (s *Server) requireAdmin(ctx context.Context, method string) error {
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(authorizationHeader); len(values) > 0 {
+			token = strings.TrimPrefix(values[0], "Bearer ")
+		}
+	}
+	switch {
+	case token == "":
+		return status.Errorf(codes.Unauthenticated, "%s requires an admin token", method)
+	case s.adminToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.adminToken)) != 1:
+		return status.Errorf(codes.PermissionDenied, "%s is only allowed for admins", method)
+	}
+	return nil
+}
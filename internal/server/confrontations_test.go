@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	gotestAssert "gotest.tools/v3/assert"
+)
+
+func TestServer_RecordConfrontation(t *testing.T) {
+	storageMock := &storageMock{
+		recordConfrontation: func(ctx context.Context, req *enemy.RecordConfrontationRequest) (*enemy.RecordConfrontationResponse, error) {
+			return &enemy.RecordConfrontationResponse{
+				Confrontation: &enemy.Confrontation{Id: "confrontation1", EnemyId: req.GetEnemyId(), Outcome: req.GetOutcome()},
+				Enemy:         &enemy.Enemy{Id: req.GetEnemyId(), Rating: 5.25},
+			}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		give    *enemy.RecordConfrontationRequest
+		want    *enemy.RecordConfrontationResponse
+		wantErr error
+	}{
+		{
+			name:    "Test empty enemy id",
+			give:    &enemy.RecordConfrontationRequest{Outcome: enemy.Confrontation_WIN},
+			wantErr: status.Error(codes.InvalidArgument, "enemy id can't be empty"),
+		},
+		{
+			name:    "Test no outcome",
+			give:    &enemy.RecordConfrontationRequest{EnemyId: "enemy1"},
+			wantErr: status.Error(codes.InvalidArgument, "outcome must be set"),
+		},
+		{
+			name:    "Test unknown outcome",
+			give:    &enemy.RecordConfrontationRequest{EnemyId: "enemy1", Outcome: 42},
+			wantErr: status.Error(codes.InvalidArgument, "unknown outcome 42"),
+		},
+		{
+			name: "Test occurred in the future",
+			give: &enemy.RecordConfrontationRequest{
+				EnemyId:    "enemy1",
+				Outcome:    enemy.Confrontation_WIN,
+				OccurredAt: timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			wantErr: status.Error(codes.InvalidArgument, "occurred at can't be in the future"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.RecordConfrontationRequest{EnemyId: "enemy1", Outcome: enemy.Confrontation_LOSS},
+			want: &enemy.RecordConfrontationResponse{
+				Confrontation: &enemy.Confrontation{Id: "confrontation1", EnemyId: "enemy1", Outcome: enemy.Confrontation_LOSS},
+				Enemy:         &enemy.Enemy{Id: "enemy1", Rating: 5.25},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(storageMock)
+			res, err := srv.RecordConfrontation(context.Background(), test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
+
+func TestServer_RecomputeRatings(t *testing.T) {
+	srv := New(&storageMock{
+		recomputeRatings: func(ctx context.Context, req *enemy.RecomputeRatingsRequest) (*enemy.RecomputeRatingsResponse, []*enemy.Enemy, error) {
+			return &enemy.RecomputeRatingsResponse{Confrontations: 3, EnemiesUpdated: 1}, []*enemy.Enemy{{Id: "enemy1"}}, nil
+		},
+	}, WithAdminToken("secret"))
+	_, sub, err := srv.events.subscribe("")
+	assert.NoError(t, err)
+	defer srv.events.unsubscribe(sub)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, "Bearer secret"))
+	res, err := srv.RecomputeRatings(ctx, &enemy.RecomputeRatingsRequest{})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.RecomputeRatingsResponse{Confrontations: 3, EnemiesUpdated: 1}, res, protocmp.Transform())
+
+	ev := <-sub
+	assert.Equal(t, enemy.EnemyEvent_UPDATED, ev.GetType())
+	assert.Equal(t, "enemy1", ev.GetEnemy().GetId())
+}
+
+func TestServer_RecomputeRatings_NotAdmin(t *testing.T) {
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, "Bearer "+token))
+	}
+	tests := []struct {
+		name       string
+		adminToken string
+		ctx        context.Context
+		wantErr    error
+	}{
+		{
+			name:       "Test no token",
+			adminToken: "secret",
+			ctx:        context.Background(),
+			wantErr:    status.Error(codes.Unauthenticated, "RecomputeRatings requires an admin token"),
+		},
+		{
+			// Saying who you are isn't enough.
+			name:       "Test admin actor without token",
+			adminToken: "secret",
+			ctx:        metadata.NewIncomingContext(context.Background(), metadata.Pairs(actorHeader, "dumbledore")),
+			wantErr:    status.Error(codes.Unauthenticated, "RecomputeRatings requires an admin token"),
+		},
+		{
+			name:       "Test wrong token",
+			adminToken: "secret",
+			ctx:        withToken("guess"),
+			wantErr:    status.Error(codes.PermissionDenied, "RecomputeRatings is only allowed for admins"),
+		},
+		{
+			name:    "Test empty token",
+			ctx:     withToken(""),
+			wantErr: status.Error(codes.Unauthenticated, "RecomputeRatings requires an admin token"),
+		},
+		{
+			name:    "Test admin RPCs disabled",
+			ctx:     withToken("secret"),
+			wantErr: status.Error(codes.PermissionDenied, "RecomputeRatings is only allowed for admins"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(&storageMock{}, WithAdminToken(test.adminToken))
+			_, err := srv.RecomputeRatings(test.ctx, &enemy.RecomputeRatingsRequest{})
+			assert.Equal(t, test.wantErr, err)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Longest grievance text accepted, in bytes.
+const maxGrievanceLength = 4096
+
+func (s *Server) AddGrievance(ctx context.Context, req *enemy.AddGrievanceRequest) (*enemy.AddGrievanceResponse, error) {
+	_, knownSeverity := enemy.Grievance_Severity_name[int32(req.GetSeverity())]
+	switch {
+	case req.GetEnemyId() == "":
+		return nil, status.Error(codes.InvalidArgument, "enemy id can't be empty")
+	case req.GetText() == "":
+		return nil, status.Error(codes.InvalidArgument, "grievance text can't be empty")
+	case len(req.GetText()) > maxGrievanceLength:
+		return nil, status.Errorf(codes.InvalidArgument, "grievance text can't be longer than %d bytes", maxGrievanceLength)
+	case req.GetSeverity() == enemy.Grievance_SEVERITY_UNSPECIFIED:
+		return nil, status.Error(codes.InvalidArgument, "severity must be set")
+	case !knownSeverity:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown severity %d", req.GetSeverity())
+	case req.GetOccurredAt() != nil && req.GetOccurredAt().AsTime().After(time.Now()):
+		return nil, status.Error(codes.InvalidArgument, "occurred at can't be in the future")
+	}
+	res, err := s.storage.AddGrievance(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (s *Server) ListGrievances(ctx context.Context, req *enemy.ListGrievancesRequest) (*enemy.ListGrievancesResponse, error) {
+	switch {
+	case req.GetEnemyId() == "":
+		return nil, status.Error(codes.InvalidArgument, "enemy id can't be empty")
+	case req.GetPageSize() < 0:
+		return nil, status.Error(codes.InvalidArgument, "page size can't be negative")
+	case req.GetPageSize() > maxPageSize:
+		req.PageSize = maxPageSize
+	}
+	res, err := s.storage.ListGrievances(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (s *Server) DeleteGrievance(ctx context.Context, req *enemy.DeleteGrievanceRequest) (*enemy.DeleteGrievanceResponse, error) {
+	switch {
+	case req.GetEnemyId() == "":
+		return nil, status.Error(codes.InvalidArgument, "enemy id can't be empty")
+	case req.GetId() == "":
+		return nil, status.Error(codes.InvalidArgument, "id can't be empty")
+	}
+	res, err := s.storage.DeleteGrievance(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
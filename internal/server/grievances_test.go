@@ -0,0 +1,184 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/internal/storage"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	gotestAssert "gotest.tools/v3/assert"
+)
+
+func TestServer_AddGrievance(t *testing.T) {
+	storageMock := &storageMock{
+		addGrievance: func(ctx context.Context, req *enemy.AddGrievanceRequest) (*enemy.AddGrievanceResponse, error) {
+			if req.GetEnemyId() != "enemy1" {
+				return nil, &storage.Error{Kind: storage.ErrNotFound, Msg: "enemy not found"}
+			}
+			return &enemy.AddGrievanceResponse{Grievance: &enemy.Grievance{Id: "grievance1", EnemyId: "enemy1", Text: req.GetText()}}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		give    *enemy.AddGrievanceRequest
+		want    *enemy.AddGrievanceResponse
+		wantErr error
+	}{
+		{
+			name:    "Test empty enemy id",
+			give:    &enemy.AddGrievanceRequest{Text: "Ate my lunch", Severity: enemy.Grievance_MINOR},
+			wantErr: status.Error(codes.InvalidArgument, "enemy id can't be empty"),
+		},
+		{
+			name:    "Test empty text",
+			give:    &enemy.AddGrievanceRequest{EnemyId: "enemy1", Severity: enemy.Grievance_MINOR},
+			wantErr: status.Error(codes.InvalidArgument, "grievance text can't be empty"),
+		},
+		{
+			name:    "Test text too long",
+			give:    &enemy.AddGrievanceRequest{EnemyId: "enemy1", Text: strings.Repeat("a", maxGrievanceLength+1), Severity: enemy.Grievance_MINOR},
+			wantErr: status.Error(codes.InvalidArgument, "grievance text can't be longer than 4096 bytes"),
+		},
+		{
+			name:    "Test no severity",
+			give:    &enemy.AddGrievanceRequest{EnemyId: "enemy1", Text: "Ate my lunch"},
+			wantErr: status.Error(codes.InvalidArgument, "severity must be set"),
+		},
+		{
+			name:    "Test unknown severity",
+			give:    &enemy.AddGrievanceRequest{EnemyId: "enemy1", Text: "Ate my lunch", Severity: 42},
+			wantErr: status.Error(codes.InvalidArgument, "unknown severity 42"),
+		},
+		{
+			name: "Test occurred in the future",
+			give: &enemy.AddGrievanceRequest{
+				EnemyId:    "enemy1",
+				Text:       "Will eat my lunch",
+				Severity:   enemy.Grievance_MINOR,
+				OccurredAt: timestamppb.New(time.Now().Add(time.Hour)),
+			},
+			wantErr: status.Error(codes.InvalidArgument, "occurred at can't be in the future"),
+		},
+		{
+			name:    "Test enemy not found",
+			give:    &enemy.AddGrievanceRequest{EnemyId: "enemy2", Text: "Ate my lunch", Severity: enemy.Grievance_MINOR},
+			wantErr: status.Error(codes.NotFound, "enemy not found"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.AddGrievanceRequest{EnemyId: "enemy1", Text: "Ate my lunch", Severity: enemy.Grievance_MINOR},
+			want: &enemy.AddGrievanceResponse{Grievance: &enemy.Grievance{Id: "grievance1", EnemyId: "enemy1", Text: "Ate my lunch"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(storageMock)
+			res, err := srv.AddGrievance(context.Background(), test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
+
+func TestServer_ListGrievances(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.ListGrievancesRequest
+		storage *storageMock
+		want    *enemy.ListGrievancesResponse
+		wantErr error
+	}{
+		{
+			name:    "Test empty enemy id",
+			give:    &enemy.ListGrievancesRequest{},
+			wantErr: status.Error(codes.InvalidArgument, "enemy id can't be empty"),
+		},
+		{
+			name:    "Test negative page size",
+			give:    &enemy.ListGrievancesRequest{EnemyId: "enemy1", PageSize: -1},
+			wantErr: status.Error(codes.InvalidArgument, "page size can't be negative"),
+		},
+		{
+			name: "Test page size capped",
+			give: &enemy.ListGrievancesRequest{EnemyId: "enemy1", PageSize: maxPageSize + 1},
+			storage: &storageMock{
+				listGrievances: func(ctx context.Context, req *enemy.ListGrievancesRequest) (*enemy.ListGrievancesResponse, error) {
+					if req.GetPageSize() != maxPageSize {
+						return nil, errors.New("page size not capped")
+					}
+					return &enemy.ListGrievancesResponse{}, nil
+				},
+			},
+			want: &enemy.ListGrievancesResponse{},
+		},
+		{
+			name: "Test some error",
+			give: &enemy.ListGrievancesRequest{EnemyId: "enemy1"},
+			storage: &storageMock{
+				listGrievances: func(ctx context.Context, req *enemy.ListGrievancesRequest) (*enemy.ListGrievancesResponse, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			wantErr: status.Error(codes.Internal, "internal error"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(test.storage)
+			res, err := srv.ListGrievances(context.Background(), test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
+
+func TestServer_DeleteGrievance(t *testing.T) {
+	storageMock := &storageMock{
+		deleteGrievance: func(ctx context.Context, req *enemy.DeleteGrievanceRequest) (*enemy.DeleteGrievanceResponse, error) {
+			return &enemy.DeleteGrievanceResponse{Grievance: &enemy.Grievance{Id: req.GetId(), EnemyId: req.GetEnemyId()}}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		give    *enemy.DeleteGrievanceRequest
+		want    *enemy.DeleteGrievanceResponse
+		wantErr error
+	}{
+		{
+			name:    "Test empty enemy id",
+			give:    &enemy.DeleteGrievanceRequest{Id: "grievance1"},
+			wantErr: status.Error(codes.InvalidArgument, "enemy id can't be empty"),
+		},
+		{
+			name:    "Test empty id",
+			give:    &enemy.DeleteGrievanceRequest{EnemyId: "enemy1"},
+			wantErr: status.Error(codes.InvalidArgument, "id can't be empty"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.DeleteGrievanceRequest{EnemyId: "enemy1", Id: "grievance1"},
+			want: &enemy.DeleteGrievanceResponse{Grievance: &enemy.Grievance{Id: "grievance1", EnemyId: "enemy1"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(storageMock)
+			res, err := srv.DeleteGrievance(context.Background(), test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
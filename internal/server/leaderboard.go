@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) GetLeaderboard(ctx context.Context, req *enemy.GetLeaderboardRequest) (*enemy.GetLeaderboardResponse, error) {
+	switch {
+	case req.GetLimit() < 0:
+		return nil, status.Error(codes.InvalidArgument, "limit can't be negative")
+	case req.GetStartTime() != nil && req.GetEndTime() != nil && !req.GetStartTime().AsTime().Before(req.GetEndTime().AsTime()):
+		return nil, status.Error(codes.InvalidArgument, "start time must be before end time")
+	case req.GetLimit() > maxPageSize:
+		req.Limit = maxPageSize
+	}
+	if req.GetTag() != "" {
+		tags, err := normalizeTags([]string{req.GetTag()})
+		if err != nil {
+			return nil, err
+		}
+		req.Tag = tags[0]
+	}
+	res, err := s.storage.GetLeaderboard(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
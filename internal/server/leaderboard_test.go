@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	gotestAssert "gotest.tools/v3/assert"
+)
+
+func TestServer_GetLeaderboard(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.GetLeaderboardRequest
+		storage *storageMock
+		want    *enemy.GetLeaderboardResponse
+		wantErr error
+	}{
+		{
+			name:    "Test negative limit",
+			give:    &enemy.GetLeaderboardRequest{Limit: -1},
+			wantErr: status.Error(codes.InvalidArgument, "limit can't be negative"),
+		},
+		{
+			name: "Test start time after end time",
+			give: &enemy.GetLeaderboardRequest{
+				StartTime: timestamppb.New(time.Date(2021, time.December, 2, 0, 0, 0, 0, time.UTC)),
+				EndTime:   timestamppb.New(time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			wantErr: status.Error(codes.InvalidArgument, "start time must be before end time"),
+		},
+		{
+			name:    "Test empty tag",
+			give:    &enemy.GetLeaderboardRequest{Tag: " "},
+			wantErr: status.Error(codes.InvalidArgument, "tag can't be empty"),
+		},
+		{
+			name: "Test limit capped and tag normalized",
+			give: &enemy.GetLeaderboardRequest{Limit: maxPageSize + 1, Tag: "Work"},
+			storage: &storageMock{
+				getLeaderboard: func(ctx context.Context, req *enemy.GetLeaderboardRequest) (*enemy.GetLeaderboardResponse, error) {
+					if req.GetLimit() != maxPageSize || req.GetTag() != "work" {
+						return nil, errors.New("request not normalized")
+					}
+					return &enemy.GetLeaderboardResponse{}, nil
+				},
+			},
+			want: &enemy.GetLeaderboardResponse{},
+		},
+		{
+			name: "Test successful",
+			give: &enemy.GetLeaderboardRequest{},
+			storage: &storageMock{
+				getLeaderboard: func(ctx context.Context, req *enemy.GetLeaderboardRequest) (*enemy.GetLeaderboardResponse, error) {
+					return &enemy.GetLeaderboardResponse{Entries: []*enemy.LeaderboardEntry{
+						{Enemy: &enemy.Enemy{Id: "enemy1"}, Rank: 1, Percentile: 100},
+					}}, nil
+				},
+			},
+			want: &enemy.GetLeaderboardResponse{Entries: []*enemy.LeaderboardEntry{
+				{Enemy: &enemy.Enemy{Id: "enemy1"}, Rank: 1, Percentile: 100},
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(test.storage)
+			res, err := srv.GetLeaderboard(context.Background(), test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Deepest GetEnemyNetwork walks, as the network can grow very fast with the
+// depth.
+const maxNetworkDepth = 5
+
+func (s *Server) LinkEnemies(ctx context.Context, req *enemy.LinkEnemiesRequest) (*enemy.LinkEnemiesResponse, error) {
+	if err := validateLink(req.GetFromEnemyId(), req.GetToEnemyId(), req.GetKind()); err != nil {
+		return nil, err
+	}
+	res, err := s.storage.LinkEnemies(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (s *Server) UnlinkEnemies(ctx context.Context, req *enemy.UnlinkEnemiesRequest) (*enemy.UnlinkEnemiesResponse, error) {
+	if err := validateLink(req.GetFromEnemyId(), req.GetToEnemyId(), req.GetKind()); err != nil {
+		return nil, err
+	}
+	res, err := s.storage.UnlinkEnemies(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func validateLink(fromEnemyID, toEnemyID string, kind enemy.Relationship_Kind) error {
+	switch {
+	case fromEnemyID == "":
+		return status.Error(codes.InvalidArgument, "from enemy id can't be empty")
+	case toEnemyID == "":
+		return status.Error(codes.InvalidArgument, "to enemy id can't be empty")
+	case fromEnemyID == toEnemyID:
+		return status.Error(codes.InvalidArgument, "an enemy can't be linked to itself")
+	}
+	return validateKind(kind)
+}
+
+func validateKind(kind enemy.Relationship_Kind) error {
+	if kind == enemy.Relationship_KIND_UNSPECIFIED {
+		return status.Error(codes.InvalidArgument, "kind must be set")
+	}
+	if _, ok := enemy.Relationship_Kind_name[int32(kind)]; !ok {
+		return status.Errorf(codes.InvalidArgument, "unknown kind %d", kind)
+	}
+	return nil
+}
+
+func (s *Server) GetEnemyNetwork(ctx context.Context, req *enemy.GetEnemyNetworkRequest) (*enemy.GetEnemyNetworkResponse, error) {
+	switch {
+	case req.GetId() == "":
+		return nil, status.Error(codes.InvalidArgument, "id can't be empty")
+	case req.GetDepth() < 0:
+		return nil, status.Error(codes.InvalidArgument, "depth can't be negative")
+	case req.GetDepth() > maxNetworkDepth:
+		return nil, status.Errorf(codes.InvalidArgument, "depth can't be more than %d", maxNetworkDepth)
+	}
+	for _, kind := range req.GetKinds() {
+		if err := validateKind(kind); err != nil {
+			return nil, err
+		}
+	}
+	res, err := s.storage.GetEnemyNetwork(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
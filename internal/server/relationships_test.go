@@ -0,0 +1,135 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/larwef/rpi-docker-test/internal/storage"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	gotestAssert "gotest.tools/v3/assert"
+)
+
+func TestServer_LinkEnemies(t *testing.T) {
+	storageMock := &storageMock{
+		linkEnemies: func(ctx context.Context, req *enemy.LinkEnemiesRequest) (*enemy.LinkEnemiesResponse, error) {
+			if req.GetToEnemyId() == "enemy3" {
+				return nil, &storage.Error{Kind: storage.ErrConflict, Msg: "enemy enemy1 is already linked to enemy enemy3 as RIVAL"}
+			}
+			return &enemy.LinkEnemiesResponse{Relationship: &enemy.Relationship{
+				FromEnemyId: req.GetFromEnemyId(),
+				ToEnemyId:   req.GetToEnemyId(),
+				Kind:        req.GetKind(),
+			}}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		give    *enemy.LinkEnemiesRequest
+		want    *enemy.LinkEnemiesResponse
+		wantErr error
+	}{
+		{
+			name:    "Test empty from enemy id",
+			give:    &enemy.LinkEnemiesRequest{ToEnemyId: "enemy2", Kind: enemy.Relationship_RIVAL},
+			wantErr: status.Error(codes.InvalidArgument, "from enemy id can't be empty"),
+		},
+		{
+			name:    "Test empty to enemy id",
+			give:    &enemy.LinkEnemiesRequest{FromEnemyId: "enemy1", Kind: enemy.Relationship_RIVAL},
+			wantErr: status.Error(codes.InvalidArgument, "to enemy id can't be empty"),
+		},
+		{
+			name:    "Test linked to itself",
+			give:    &enemy.LinkEnemiesRequest{FromEnemyId: "enemy1", ToEnemyId: "enemy1", Kind: enemy.Relationship_RIVAL},
+			wantErr: status.Error(codes.InvalidArgument, "an enemy can't be linked to itself"),
+		},
+		{
+			name:    "Test no kind",
+			give:    &enemy.LinkEnemiesRequest{FromEnemyId: "enemy1", ToEnemyId: "enemy2"},
+			wantErr: status.Error(codes.InvalidArgument, "kind must be set"),
+		},
+		{
+			name:    "Test unknown kind",
+			give:    &enemy.LinkEnemiesRequest{FromEnemyId: "enemy1", ToEnemyId: "enemy2", Kind: 42},
+			wantErr: status.Error(codes.InvalidArgument, "unknown kind 42"),
+		},
+		{
+			name:    "Test already linked",
+			give:    &enemy.LinkEnemiesRequest{FromEnemyId: "enemy1", ToEnemyId: "enemy3", Kind: enemy.Relationship_RIVAL},
+			wantErr: status.Error(codes.AlreadyExists, "enemy enemy1 is already linked to enemy enemy3 as RIVAL"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.LinkEnemiesRequest{FromEnemyId: "enemy1", ToEnemyId: "enemy2", Kind: enemy.Relationship_REPORTS_TO},
+			want: &enemy.LinkEnemiesResponse{Relationship: &enemy.Relationship{
+				FromEnemyId: "enemy1",
+				ToEnemyId:   "enemy2",
+				Kind:        enemy.Relationship_REPORTS_TO,
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(storageMock)
+			res, err := srv.LinkEnemies(context.Background(), test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
+
+func TestServer_GetEnemyNetwork(t *testing.T) {
+	storageMock := &storageMock{
+		getEnemyNetwork: func(ctx context.Context, req *enemy.GetEnemyNetworkRequest) (*enemy.GetEnemyNetworkResponse, error) {
+			return &enemy.GetEnemyNetworkResponse{Nodes: []*enemy.NetworkNode{{Enemy: &enemy.Enemy{Id: req.GetId()}}}}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		give    *enemy.GetEnemyNetworkRequest
+		want    *enemy.GetEnemyNetworkResponse
+		wantErr error
+	}{
+		{
+			name:    "Test empty id",
+			give:    &enemy.GetEnemyNetworkRequest{},
+			wantErr: status.Error(codes.InvalidArgument, "id can't be empty"),
+		},
+		{
+			name:    "Test negative depth",
+			give:    &enemy.GetEnemyNetworkRequest{Id: "enemy1", Depth: -1},
+			wantErr: status.Error(codes.InvalidArgument, "depth can't be negative"),
+		},
+		{
+			name:    "Test depth too large",
+			give:    &enemy.GetEnemyNetworkRequest{Id: "enemy1", Depth: maxNetworkDepth + 1},
+			wantErr: status.Error(codes.InvalidArgument, "depth can't be more than 5"),
+		},
+		{
+			name:    "Test unspecified kind",
+			give:    &enemy.GetEnemyNetworkRequest{Id: "enemy1", Kinds: []enemy.Relationship_Kind{enemy.Relationship_ALLY, enemy.Relationship_KIND_UNSPECIFIED}},
+			wantErr: status.Error(codes.InvalidArgument, "kind must be set"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.GetEnemyNetworkRequest{Id: "enemy1", Depth: 2, Kinds: []enemy.Relationship_Kind{enemy.Relationship_ALLY}},
+			want: &enemy.GetEnemyNetworkResponse{Nodes: []*enemy.NetworkNode{{Enemy: &enemy.Enemy{Id: "enemy1"}}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(storageMock)
+			res, err := srv.GetEnemyNetwork(context.Background(), test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
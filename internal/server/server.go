@@ -2,54 +2,350 @@ package server
 
 import (
 	"context"
+	"errors"
+	"log"
 
+	"github.com/larwef/rpi-docker-test/internal/filter"
+	"github.com/larwef/rpi-docker-test/internal/orderby"
+	"github.com/larwef/rpi-docker-test/internal/storage"
 	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// Upper limit on the number of enemies returned by a single ListEnemies call.
+const maxPageSize = 1000
+
+// Metadata key clients can use instead of AddEnemyRequest.idempotencyKey.
+const idempotencyKeyHeader = "idempotency-key"
+
+// Longest idempotency key accepted.
+const maxIdempotencyKeyLength = 128
+
+// Fields ListEnemies can be ordered by, and the name the storage knows each
+// of them by.
+var orderableFields = map[string]string{
+	"name":         "name",
+	"email":        "email",
+	"rating":       "rating",
+	"lastUpdated":  "lastUpdated",
+	"last_updated": "lastUpdated",
+}
+
 type Storage interface {
-	AddEnemy(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, error)
+	// AddEnemy also reports whether the response was replayed for an
+	// idempotency key used before.
+	AddEnemy(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error)
 	GetEnemy(ctx context.Context, req *enemy.GetEnemyRequest) (*enemy.GetEnemyResponse, error)
 	UpdateEnemy(ctx context.Context, req *enemy.UpdateEnemyRequest) (*enemy.UpdateEnemyResponse, error)
 	ListEnemies(ctx context.Context, req *enemy.ListEnemiesRequest) (*enemy.ListEnemiesResponse, error)
+	DeleteEnemy(ctx context.Context, req *enemy.DeleteEnemyRequest) (*enemy.DeleteEnemyResponse, error)
+	UndeleteEnemy(ctx context.Context, req *enemy.UndeleteEnemyRequest) (*enemy.UndeleteEnemyResponse, error)
+	PurgeEnemy(ctx context.Context, req *enemy.PurgeEnemyRequest) (*enemy.PurgeEnemyResponse, error)
+	GetRatingHistory(ctx context.Context, req *enemy.GetRatingHistoryRequest) (*enemy.GetRatingHistoryResponse, error)
+	ListAuditEvents(ctx context.Context, req *enemy.ListAuditEventsRequest) (*enemy.ListAuditEventsResponse, error)
+	BatchAddEnemies(ctx context.Context, reqs []*enemy.AddEnemyRequest, atomic bool) ([]storage.BatchResult, error)
+	BatchGetEnemies(ctx context.Context, req *enemy.BatchGetEnemiesRequest) (*enemy.BatchGetEnemiesResponse, error)
+	BatchUpdateEnemies(ctx context.Context, req *enemy.BatchUpdateEnemiesRequest) (*enemy.BatchUpdateEnemiesResponse, error)
+	BulkLoadEnemies(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, []*enemy.EnemyEvent, error)
+	DryRunAddEnemies(ctx context.Context, next func() (*enemy.AddEnemyRequest, error)) ([]*enemy.RejectedRow, error)
+	DryRunBulkLoadEnemies(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, error)
+	AddTags(ctx context.Context, req *enemy.AddTagsRequest) (*enemy.AddTagsResponse, error)
+	RemoveTags(ctx context.Context, req *enemy.RemoveTagsRequest) (*enemy.RemoveTagsResponse, error)
+	ListTags(ctx context.Context, req *enemy.ListTagsRequest) (*enemy.ListTagsResponse, error)
+	AddGrievance(ctx context.Context, req *enemy.AddGrievanceRequest) (*enemy.AddGrievanceResponse, error)
+	ListGrievances(ctx context.Context, req *enemy.ListGrievancesRequest) (*enemy.ListGrievancesResponse, error)
+	DeleteGrievance(ctx context.Context, req *enemy.DeleteGrievanceRequest) (*enemy.DeleteGrievanceResponse, error)
+	LinkEnemies(ctx context.Context, req *enemy.LinkEnemiesRequest) (*enemy.LinkEnemiesResponse, error)
+	UnlinkEnemies(ctx context.Context, req *enemy.UnlinkEnemiesRequest) (*enemy.UnlinkEnemiesResponse, error)
+	GetEnemyNetwork(ctx context.Context, req *enemy.GetEnemyNetworkRequest) (*enemy.GetEnemyNetworkResponse, error)
+	GetLeaderboard(ctx context.Context, req *enemy.GetLeaderboardRequest) (*enemy.GetLeaderboardResponse, error)
+	GetStats(ctx context.Context, req *enemy.GetStatsRequest) (*enemy.GetStatsResponse, error)
+	RecordConfrontation(ctx context.Context, req *enemy.RecordConfrontationRequest) (*enemy.RecordConfrontationResponse, error)
+	RecomputeRatings(ctx context.Context, req *enemy.RecomputeRatingsRequest) (*enemy.RecomputeRatingsResponse, []*enemy.Enemy, error)
+	CastVote(ctx context.Context, req *enemy.CastVoteRequest) (*enemy.CastVoteResponse, error)
 }
 
 type Server struct {
 	enemy.UnimplementedEnemyServiceServer
 	storage Storage
+	// Changes made through this server, for WatchEnemies.
+	events *broker
+	// Changes made through any replica, for SubscribeChanges.
+	changes *broker
+	// Largest number of enemies accepted by the batch RPCs.
+	maxBatchSize int
+	// Token callers of admin RPCs must send. Admin RPCs are disabled when
+	// empty.
+	adminToken string
+}
+
+type Option func(*Server)
+
+// WithMaxBatchSize sets the largest number of enemies accepted by the batch
+// RPCs. The default is 1000.
+func WithMaxBatchSize(n int) Option {
+	return func(s *Server) {
+		s.maxBatchSize = n
+	}
 }
 
-func New(s Storage) *Server {
-	return &Server{storage: s}
+// WithAdminToken sets the token callers of admin RPCs, like RecomputeRatings,
+// must send as "authorization: Bearer <token>" metadata. By default admin RPCs
+// are disabled.
+func WithAdminToken(token string) Option {
+	return func(s *Server) {
+		s.adminToken = token
+	}
+}
+
+func New(s Storage, opts ...Option) *Server {
+	srv := &Server{
+		storage:      s,
+		events:       newBroker(),
+		changes:      newBroker(),
+		maxBatchSize: defaultMaxBatchSize,
+	}
+	for _, opt := range opts {
+		opt(srv)
+	}
+	return srv
 }
 
 func (s *Server) AddEnemy(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, error) {
+	if err := validateAddEnemy(req); err != nil {
+		return nil, err
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(idempotencyKeyHeader); len(keys) > 0 {
+			switch {
+			case req.GetIdempotencyKey() == "":
+				req.IdempotencyKey = keys[0]
+			case req.GetIdempotencyKey() != keys[0]:
+				return nil, status.Error(codes.InvalidArgument, "idempotency key in request and metadata differ")
+			}
+		}
+	}
+	if err := validateIdempotencyKey(req.GetIdempotencyKey()); err != nil {
+		return nil, err
+	}
+	res, replayed, err := s.storage.AddEnemy(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	// A replayed response was published when the enemy was added.
+	if !replayed {
+		s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_ADDED, Enemy: res.GetEnemy()})
+	}
+	return res, nil
+}
+
+func validateIdempotencyKey(key string) error {
+	if len(key) > maxIdempotencyKeyLength {
+		return status.Errorf(codes.InvalidArgument, "idempotency key can't be longer than %d characters", maxIdempotencyKeyLength)
+	}
+	return nil
+}
+
+// validateAddEnemy also normalizes the tags of req.
+func validateAddEnemy(req *enemy.AddEnemyRequest) error {
 	switch {
 	case req.GetName() == "":
-		return nil, status.Error(codes.InvalidArgument, "enemy name can't be empty")
+		return status.Error(codes.InvalidArgument, "enemy name can't be empty")
 	case req.GetEmail() == "":
-		return nil, status.Error(codes.InvalidArgument, "enemy email can't be empty")
-	case req.GetRating() == 0.0:
-		return nil, status.Error(codes.InvalidArgument, "rating must be > 0")
+		return status.Error(codes.InvalidArgument, "enemy email can't be empty")
+	}
+	if err := storage.ValidateRating(req.GetRating()); err != nil {
+		return toStatus(err)
 	}
-	return s.storage.AddEnemy(ctx, req)
+	tags, err := normalizeTags(req.GetTags())
+	if err != nil {
+		return err
+	}
+	req.Tags = tags
+	return nil
 }
 
 func (s *Server) GetEnemy(ctx context.Context, req *enemy.GetEnemyRequest) (*enemy.GetEnemyResponse, error) {
 	if req.GetId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "id can't be empty")
 	}
-	return s.storage.GetEnemy(ctx, req)
+	res, err := s.storage.GetEnemy(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
 }
 
 func (s *Server) UpdateEnemy(ctx context.Context, req *enemy.UpdateEnemyRequest) (*enemy.UpdateEnemyResponse, error) {
 	if req.GetId() == "" {
 		return nil, status.Error(codes.InvalidArgument, "id can't be empty")
 	}
-	return s.storage.UpdateEnemy(ctx, req)
+	tags, err := normalizeTags(req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+	req.Tags = tags
+	res, err := s.storage.UpdateEnemy(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: res.GetEnemy()})
+	return res, nil
 }
 
 func (s *Server) ListEnemies(ctx context.Context, req *enemy.ListEnemiesRequest) (*enemy.ListEnemiesResponse, error) {
-	return s.storage.ListEnemies(ctx, req)
+	switch {
+	case req.GetPageSize() < 0:
+		return nil, status.Error(codes.InvalidArgument, "page size can't be negative")
+	case req.GetPageSize() > maxPageSize:
+		req.PageSize = maxPageSize
+	}
+	if _, err := filter.Parse(req.GetFilter()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	terms, err := orderby.Parse(req.GetOrderBy())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid order by: %v", err)
+	}
+	// Aliases are resolved first, so a field can't be ordered by twice under
+	// different names.
+	seen := map[string]bool{}
+	for i, term := range terms {
+		field, ok := orderableFields[term.Field]
+		switch {
+		case !ok:
+			return nil, status.Errorf(codes.InvalidArgument, "can't order by %q", term.Field)
+		case seen[field]:
+			return nil, status.Errorf(codes.InvalidArgument, "%q is ordered by more than once", field)
+		}
+		seen[field] = true
+		terms[i].Field = field
+	}
+	req.OrderBy = orderby.String(terms)
+	if req.AnyTags, err = normalizeTags(req.GetAnyTags()); err != nil {
+		return nil, err
+	}
+	if req.AllTags, err = normalizeTags(req.GetAllTags()); err != nil {
+		return nil, err
+	}
+	res, err := s.storage.ListEnemies(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (s *Server) DeleteEnemy(ctx context.Context, req *enemy.DeleteEnemyRequest) (*enemy.DeleteEnemyResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id can't be empty")
+	}
+	res, err := s.storage.DeleteEnemy(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_DELETED, Enemy: res.GetEnemy()})
+	return res, nil
+}
+
+func (s *Server) UndeleteEnemy(ctx context.Context, req *enemy.UndeleteEnemyRequest) (*enemy.UndeleteEnemyResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id can't be empty")
+	}
+	res, err := s.storage.UndeleteEnemy(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_ADDED, Enemy: res.GetEnemy()})
+	return res, nil
+}
+
+func (s *Server) PurgeEnemy(ctx context.Context, req *enemy.PurgeEnemyRequest) (*enemy.PurgeEnemyResponse, error) {
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id can't be empty")
+	}
+	res, err := s.storage.PurgeEnemy(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_DELETED, Enemy: res.GetEnemy()})
+	return res, nil
+}
+
+func (s *Server) GetRatingHistory(ctx context.Context, req *enemy.GetRatingHistoryRequest) (*enemy.GetRatingHistoryResponse, error) {
+	switch {
+	case req.GetId() == "":
+		return nil, status.Error(codes.InvalidArgument, "id can't be empty")
+	case req.GetPageSize() < 0:
+		return nil, status.Error(codes.InvalidArgument, "page size can't be negative")
+	case req.GetStartTime() != nil && req.GetEndTime() != nil && !req.GetStartTime().AsTime().Before(req.GetEndTime().AsTime()):
+		return nil, status.Error(codes.InvalidArgument, "start time must be before end time")
+	case req.GetPageSize() > maxPageSize:
+		req.PageSize = maxPageSize
+	}
+	res, err := s.storage.GetRatingHistory(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func (s *Server) ListAuditEvents(ctx context.Context, req *enemy.ListAuditEventsRequest) (*enemy.ListAuditEventsResponse, error) {
+	switch {
+	case req.GetPageSize() < 0:
+		return nil, status.Error(codes.InvalidArgument, "page size can't be negative")
+	case req.GetStartTime() != nil && req.GetEndTime() != nil && !req.GetStartTime().AsTime().Before(req.GetEndTime().AsTime()):
+		return nil, status.Error(codes.InvalidArgument, "start time must be before end time")
+	case req.GetPageSize() > maxPageSize:
+		req.PageSize = maxPageSize
+	}
+	res, err := s.storage.ListAuditEvents(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+// toStatus translates storage errors into gRPC status errors. Unexpected errors
+// are logged and hidden from the client.
+func toStatus(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	var code codes.Code
+	switch {
+	case errors.Is(err, storage.ErrInvalidArgument):
+		code = codes.InvalidArgument
+	case errors.Is(err, storage.ErrNotFound):
+		code = codes.NotFound
+	case errors.Is(err, storage.ErrConflict):
+		code = codes.AlreadyExists
+	case errors.Is(err, storage.ErrPrecondition):
+		code = codes.FailedPrecondition
+	case errors.Is(err, storage.ErrAborted):
+		code = codes.Aborted
+	case errors.Is(err, storage.ErrUnavailable):
+		code = codes.Unavailable
+	case errors.Is(err, storage.ErrTimeout):
+		code = codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	default:
+		log.Printf("internal error: %v", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+	st := status.New(code, err.Error())
+	var storageErr *storage.Error
+	if errors.As(err, &storageErr) && storageErr.ID != "" {
+		// Lets clients find the enemy without parsing the message.
+		if withDetails, detailsErr := st.WithDetails(&errdetails.ResourceInfo{
+			ResourceType: "enemy",
+			ResourceName: storageErr.ID,
+		}); detailsErr == nil {
+			st = withDetails
+		}
+	}
+	return st.Err()
 }
@@ -3,12 +3,18 @@ package server
 import (
 	"context"
 	"errors"
+	"math"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/larwef/rpi-docker-test/internal/storage"
 	"github.com/larwef/rpi-docker-test/pkg/enemy"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -16,13 +22,38 @@ import (
 )
 
 type storageMock struct {
-	addEnemy    func(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, error)
-	getEnemy    func(ctx context.Context, req *enemy.GetEnemyRequest) (*enemy.GetEnemyResponse, error)
-	updateEnemy func(ctx context.Context, req *enemy.UpdateEnemyRequest) (*enemy.UpdateEnemyResponse, error)
-	listEnemies func(ctx context.Context, req *enemy.ListEnemiesRequest) (*enemy.ListEnemiesResponse, error)
+	addEnemy              func(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error)
+	getEnemy              func(ctx context.Context, req *enemy.GetEnemyRequest) (*enemy.GetEnemyResponse, error)
+	updateEnemy           func(ctx context.Context, req *enemy.UpdateEnemyRequest) (*enemy.UpdateEnemyResponse, error)
+	listEnemies           func(ctx context.Context, req *enemy.ListEnemiesRequest) (*enemy.ListEnemiesResponse, error)
+	deleteEnemy           func(ctx context.Context, req *enemy.DeleteEnemyRequest) (*enemy.DeleteEnemyResponse, error)
+	undeleteEnemy         func(ctx context.Context, req *enemy.UndeleteEnemyRequest) (*enemy.UndeleteEnemyResponse, error)
+	purgeEnemy            func(ctx context.Context, req *enemy.PurgeEnemyRequest) (*enemy.PurgeEnemyResponse, error)
+	getRatingHistory      func(ctx context.Context, req *enemy.GetRatingHistoryRequest) (*enemy.GetRatingHistoryResponse, error)
+	listAuditEvents       func(ctx context.Context, req *enemy.ListAuditEventsRequest) (*enemy.ListAuditEventsResponse, error)
+	batchAddEnemies       func(ctx context.Context, reqs []*enemy.AddEnemyRequest, atomic bool) ([]storage.BatchResult, error)
+	batchGetEnemies       func(ctx context.Context, req *enemy.BatchGetEnemiesRequest) (*enemy.BatchGetEnemiesResponse, error)
+	batchUpdateEnemies    func(ctx context.Context, req *enemy.BatchUpdateEnemiesRequest) (*enemy.BatchUpdateEnemiesResponse, error)
+	bulkLoadEnemies       func(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, []*enemy.EnemyEvent, error)
+	dryRunAddEnemies      func(ctx context.Context, next func() (*enemy.AddEnemyRequest, error)) ([]*enemy.RejectedRow, error)
+	dryRunBulkLoadEnemies func(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, error)
+	addTags               func(ctx context.Context, req *enemy.AddTagsRequest) (*enemy.AddTagsResponse, error)
+	removeTags            func(ctx context.Context, req *enemy.RemoveTagsRequest) (*enemy.RemoveTagsResponse, error)
+	listTags              func(ctx context.Context, req *enemy.ListTagsRequest) (*enemy.ListTagsResponse, error)
+	addGrievance          func(ctx context.Context, req *enemy.AddGrievanceRequest) (*enemy.AddGrievanceResponse, error)
+	listGrievances        func(ctx context.Context, req *enemy.ListGrievancesRequest) (*enemy.ListGrievancesResponse, error)
+	deleteGrievance       func(ctx context.Context, req *enemy.DeleteGrievanceRequest) (*enemy.DeleteGrievanceResponse, error)
+	linkEnemies           func(ctx context.Context, req *enemy.LinkEnemiesRequest) (*enemy.LinkEnemiesResponse, error)
+	unlinkEnemies         func(ctx context.Context, req *enemy.UnlinkEnemiesRequest) (*enemy.UnlinkEnemiesResponse, error)
+	getEnemyNetwork       func(ctx context.Context, req *enemy.GetEnemyNetworkRequest) (*enemy.GetEnemyNetworkResponse, error)
+	getLeaderboard        func(ctx context.Context, req *enemy.GetLeaderboardRequest) (*enemy.GetLeaderboardResponse, error)
+	getStats              func(ctx context.Context, req *enemy.GetStatsRequest) (*enemy.GetStatsResponse, error)
+	recordConfrontation   func(ctx context.Context, req *enemy.RecordConfrontationRequest) (*enemy.RecordConfrontationResponse, error)
+	recomputeRatings      func(ctx context.Context, req *enemy.RecomputeRatingsRequest) (*enemy.RecomputeRatingsResponse, []*enemy.Enemy, error)
+	castVote              func(ctx context.Context, req *enemy.CastVoteRequest) (*enemy.CastVoteResponse, error)
 }
 
-func (s *storageMock) AddEnemy(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, error) {
+func (s *storageMock) AddEnemy(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error) {
 	return s.addEnemy(ctx, req)
 }
 
@@ -38,10 +69,111 @@ func (s *storageMock) ListEnemies(ctx context.Context, req *enemy.ListEnemiesReq
 	return s.listEnemies(ctx, req)
 }
 
+func (s *storageMock) DeleteEnemy(ctx context.Context, req *enemy.DeleteEnemyRequest) (*enemy.DeleteEnemyResponse, error) {
+	return s.deleteEnemy(ctx, req)
+}
+
+func (s *storageMock) UndeleteEnemy(ctx context.Context, req *enemy.UndeleteEnemyRequest) (*enemy.UndeleteEnemyResponse, error) {
+	return s.undeleteEnemy(ctx, req)
+}
+
+func (s *storageMock) PurgeEnemy(ctx context.Context, req *enemy.PurgeEnemyRequest) (*enemy.PurgeEnemyResponse, error) {
+	return s.purgeEnemy(ctx, req)
+}
+
+func (s *storageMock) GetRatingHistory(ctx context.Context, req *enemy.GetRatingHistoryRequest) (*enemy.GetRatingHistoryResponse, error) {
+	return s.getRatingHistory(ctx, req)
+}
+
+func (s *storageMock) ListAuditEvents(ctx context.Context, req *enemy.ListAuditEventsRequest) (*enemy.ListAuditEventsResponse, error) {
+	return s.listAuditEvents(ctx, req)
+}
+
+func (s *storageMock) BatchAddEnemies(ctx context.Context, reqs []*enemy.AddEnemyRequest, atomic bool) ([]storage.BatchResult, error) {
+	return s.batchAddEnemies(ctx, reqs, atomic)
+}
+
+func (s *storageMock) BatchGetEnemies(ctx context.Context, req *enemy.BatchGetEnemiesRequest) (*enemy.BatchGetEnemiesResponse, error) {
+	return s.batchGetEnemies(ctx, req)
+}
+
+func (s *storageMock) BatchUpdateEnemies(ctx context.Context, req *enemy.BatchUpdateEnemiesRequest) (*enemy.BatchUpdateEnemiesResponse, error) {
+	return s.batchUpdateEnemies(ctx, req)
+}
+
+func (s *storageMock) BulkLoadEnemies(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, []*enemy.EnemyEvent, error) {
+	return s.bulkLoadEnemies(ctx, next)
+}
+
+func (s *storageMock) DryRunAddEnemies(ctx context.Context, next func() (*enemy.AddEnemyRequest, error)) ([]*enemy.RejectedRow, error) {
+	return s.dryRunAddEnemies(ctx, next)
+}
+
+func (s *storageMock) DryRunBulkLoadEnemies(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, error) {
+	return s.dryRunBulkLoadEnemies(ctx, next)
+}
+
+func (s *storageMock) AddTags(ctx context.Context, req *enemy.AddTagsRequest) (*enemy.AddTagsResponse, error) {
+	return s.addTags(ctx, req)
+}
+
+func (s *storageMock) RemoveTags(ctx context.Context, req *enemy.RemoveTagsRequest) (*enemy.RemoveTagsResponse, error) {
+	return s.removeTags(ctx, req)
+}
+
+func (s *storageMock) ListTags(ctx context.Context, req *enemy.ListTagsRequest) (*enemy.ListTagsResponse, error) {
+	return s.listTags(ctx, req)
+}
+
+func (s *storageMock) AddGrievance(ctx context.Context, req *enemy.AddGrievanceRequest) (*enemy.AddGrievanceResponse, error) {
+	return s.addGrievance(ctx, req)
+}
+
+func (s *storageMock) ListGrievances(ctx context.Context, req *enemy.ListGrievancesRequest) (*enemy.ListGrievancesResponse, error) {
+	return s.listGrievances(ctx, req)
+}
+
+func (s *storageMock) DeleteGrievance(ctx context.Context, req *enemy.DeleteGrievanceRequest) (*enemy.DeleteGrievanceResponse, error) {
+	return s.deleteGrievance(ctx, req)
+}
+
+func (s *storageMock) LinkEnemies(ctx context.Context, req *enemy.LinkEnemiesRequest) (*enemy.LinkEnemiesResponse, error) {
+	return s.linkEnemies(ctx, req)
+}
+
+func (s *storageMock) UnlinkEnemies(ctx context.Context, req *enemy.UnlinkEnemiesRequest) (*enemy.UnlinkEnemiesResponse, error) {
+	return s.unlinkEnemies(ctx, req)
+}
+
+func (s *storageMock) GetEnemyNetwork(ctx context.Context, req *enemy.GetEnemyNetworkRequest) (*enemy.GetEnemyNetworkResponse, error) {
+	return s.getEnemyNetwork(ctx, req)
+}
+
+func (s *storageMock) GetLeaderboard(ctx context.Context, req *enemy.GetLeaderboardRequest) (*enemy.GetLeaderboardResponse, error) {
+	return s.getLeaderboard(ctx, req)
+}
+
+func (s *storageMock) GetStats(ctx context.Context, req *enemy.GetStatsRequest) (*enemy.GetStatsResponse, error) {
+	return s.getStats(ctx, req)
+}
+
+func (s *storageMock) RecordConfrontation(ctx context.Context, req *enemy.RecordConfrontationRequest) (*enemy.RecordConfrontationResponse, error) {
+	return s.recordConfrontation(ctx, req)
+}
+
+func (s *storageMock) RecomputeRatings(ctx context.Context, req *enemy.RecomputeRatingsRequest) (*enemy.RecomputeRatingsResponse, []*enemy.Enemy, error) {
+	return s.recomputeRatings(ctx, req)
+}
+
+func (s *storageMock) CastVote(ctx context.Context, req *enemy.CastVoteRequest) (*enemy.CastVoteResponse, error) {
+	return s.castVote(ctx, req)
+}
+
 func TestServer_AddEnemy(t *testing.T) {
 	tests := []struct {
 		name    string
 		give    *enemy.AddEnemyRequest
+		md      metadata.MD
 		storage *storageMock
 		want    *enemy.AddEnemyResponse
 		wantErr error
@@ -54,11 +186,11 @@ func TestServer_AddEnemy(t *testing.T) {
 				Rating: 1.1,
 			},
 			storage: &storageMock{
-				addEnemy: func(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, error) {
-					return nil, errors.New("some error")
+				addEnemy: func(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error) {
+					return nil, false, errors.New("some error")
 				},
 			},
-			wantErr: errors.New("some error"),
+			wantErr: status.Error(codes.Internal, "internal error"),
 		},
 		{
 			name:    "Test empty name",
@@ -80,6 +212,63 @@ func TestServer_AddEnemy(t *testing.T) {
 			},
 			wantErr: status.Error(codes.InvalidArgument, "rating must be > 0"),
 		},
+		{
+			name: "Test negative rating",
+			give: &enemy.AddEnemyRequest{
+				Name:   "Some Enemy",
+				Email:  "someenemy@bar.com",
+				Rating: -1,
+			},
+			wantErr: status.Error(codes.InvalidArgument, "rating must be > 0"),
+		},
+		{
+			name: "Test infinite rating",
+			give: &enemy.AddEnemyRequest{
+				Name:   "Some Enemy",
+				Email:  "someenemy@bar.com",
+				Rating: float32(math.Inf(1)),
+			},
+			wantErr: status.Error(codes.InvalidArgument, "rating must be finite"),
+		},
+		{
+			name: "Test idempotency key from metadata",
+			give: &enemy.AddEnemyRequest{
+				Name:   "Enemy One",
+				Email:  "enemy1@bar.com",
+				Rating: 1.1,
+			},
+			md: metadata.Pairs("idempotency-key", "someKey"),
+			storage: &storageMock{
+				addEnemy: func(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error) {
+					if req.GetIdempotencyKey() != "someKey" {
+						return nil, false, errors.New("idempotency key not set")
+					}
+					return &enemy.AddEnemyResponse{}, false, nil
+				},
+			},
+			want: &enemy.AddEnemyResponse{},
+		},
+		{
+			name: "Test idempotency key in metadata and request differ",
+			give: &enemy.AddEnemyRequest{
+				Name:           "Enemy One",
+				Email:          "enemy1@bar.com",
+				Rating:         1.1,
+				IdempotencyKey: "someKey",
+			},
+			md:      metadata.Pairs("idempotency-key", "someOtherKey"),
+			wantErr: status.Error(codes.InvalidArgument, "idempotency key in request and metadata differ"),
+		},
+		{
+			name: "Test idempotency key too long",
+			give: &enemy.AddEnemyRequest{
+				Name:           "Enemy One",
+				Email:          "enemy1@bar.com",
+				Rating:         1.1,
+				IdempotencyKey: strings.Repeat("k", 129),
+			},
+			wantErr: status.Error(codes.InvalidArgument, "idempotency key can't be longer than 128 characters"),
+		},
 		{
 			name: "Test successful",
 			give: &enemy.AddEnemyRequest{
@@ -88,7 +277,7 @@ func TestServer_AddEnemy(t *testing.T) {
 				Rating: 1.1,
 			},
 			storage: &storageMock{
-				addEnemy: func(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, error) {
+				addEnemy: func(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error) {
 					return &enemy.AddEnemyResponse{
 						Enemy: &enemy.Enemy{
 							Id:          "someEnemy",
@@ -97,7 +286,7 @@ func TestServer_AddEnemy(t *testing.T) {
 							Rating:      1.1,
 							LastUpdated: timestamppb.New(time.Date(2021, time.December, 30, 20, 57, 35, 0, time.UTC)),
 						},
-					}, nil
+					}, false, nil
 				},
 			},
 			want: &enemy.AddEnemyResponse{
@@ -114,7 +303,8 @@ func TestServer_AddEnemy(t *testing.T) {
 
 	for _, test := range tests {
 		srv := New(test.storage)
-		res, err := srv.AddEnemy(context.Background(), test.give)
+		ctx := metadata.NewIncomingContext(context.Background(), test.md)
+		res, err := srv.AddEnemy(ctx, test.give)
 		gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
 		assert.Equal(t, test.wantErr, err)
 	}
@@ -136,7 +326,7 @@ func TestServer_GetEnemy(t *testing.T) {
 					return nil, errors.New("some error")
 				},
 			},
-			wantErr: errors.New("some error"),
+			wantErr: status.Error(codes.Internal, "internal error"),
 		},
 		{
 			name:    "Test empty id",
@@ -195,7 +385,7 @@ func TestServer_UpdateEnemy(t *testing.T) {
 					return nil, errors.New("some error")
 				},
 			},
-			wantErr: errors.New("some error"),
+			wantErr: status.Error(codes.Internal, "internal error"),
 		},
 		{
 			name:    "Test empty id",
@@ -259,7 +449,81 @@ func TestServer_ListEnemies(t *testing.T) {
 					return nil, errors.New("some error")
 				},
 			},
-			wantErr: errors.New("some error"),
+			wantErr: status.Error(codes.Internal, "internal error"),
+		},
+		{
+			name:    "Test negative page size",
+			give:    &enemy.ListEnemiesRequest{PageSize: -1},
+			wantErr: status.Error(codes.InvalidArgument, "page size can't be negative"),
+		},
+		{
+			name:    "Test invalid filter",
+			give:    &enemy.ListEnemiesRequest{Filter: "rating > 7 AND age > 3"},
+			wantErr: status.Error(codes.InvalidArgument, `invalid filter: unknown field "age" at position 16`),
+		},
+		{
+			name:    "Test invalid order by",
+			give:    &enemy.ListEnemiesRequest{OrderBy: "rating sideways"},
+			wantErr: status.Error(codes.InvalidArgument, `invalid order by: term 1 has invalid direction "sideways"`),
+		},
+		{
+			name:    "Test order by field not allowed",
+			give:    &enemy.ListEnemiesRequest{OrderBy: "rating desc, id"},
+			wantErr: status.Error(codes.InvalidArgument, `can't order by "id"`),
+		},
+		{
+			name:    "Test order by field repeated",
+			give:    &enemy.ListEnemiesRequest{OrderBy: "rating desc, rating"},
+			wantErr: status.Error(codes.InvalidArgument, `"rating" is ordered by more than once`),
+		},
+		{
+			name:    "Test order by field repeated under an alias",
+			give:    &enemy.ListEnemiesRequest{OrderBy: "last_updated, lastUpdated desc"},
+			wantErr: status.Error(codes.InvalidArgument, `"lastUpdated" is ordered by more than once`),
+		},
+		{
+			name: "Test order by aliases resolved",
+			give: &enemy.ListEnemiesRequest{OrderBy: "rating desc,last_updated"},
+			storage: &storageMock{
+				listEnemies: func(ctx context.Context, req *enemy.ListEnemiesRequest) (*enemy.ListEnemiesResponse, error) {
+					if req.GetOrderBy() != "rating desc, lastUpdated" {
+						return nil, errors.New("order by not normalized")
+					}
+					return &enemy.ListEnemiesResponse{}, nil
+				},
+			},
+			want: &enemy.ListEnemiesResponse{},
+		},
+		{
+			name: "Test page size capped",
+			give: &enemy.ListEnemiesRequest{PageSize: maxPageSize + 1},
+			storage: &storageMock{
+				listEnemies: func(ctx context.Context, req *enemy.ListEnemiesRequest) (*enemy.ListEnemiesResponse, error) {
+					if req.GetPageSize() != maxPageSize {
+						return nil, errors.New("page size not capped")
+					}
+					return &enemy.ListEnemiesResponse{}, nil
+				},
+			},
+			want: &enemy.ListEnemiesResponse{},
+		},
+		{
+			name: "Test tags normalized",
+			give: &enemy.ListEnemiesRequest{AnyTags: []string{" Work", "school"}, AllTags: []string{"work", "WORK"}},
+			storage: &storageMock{
+				listEnemies: func(ctx context.Context, req *enemy.ListEnemiesRequest) (*enemy.ListEnemiesResponse, error) {
+					if !reflect.DeepEqual(req.GetAnyTags(), []string{"school", "work"}) || !reflect.DeepEqual(req.GetAllTags(), []string{"work"}) {
+						return nil, errors.New("tags not normalized")
+					}
+					return &enemy.ListEnemiesResponse{}, nil
+				},
+			},
+			want: &enemy.ListEnemiesResponse{},
+		},
+		{
+			name:    "Test empty tag",
+			give:    &enemy.ListEnemiesRequest{AnyTags: []string{"work", " "}},
+			wantErr: status.Error(codes.InvalidArgument, "tag can't be empty"),
 		},
 		{
 			name: "Test successful",
@@ -329,3 +593,348 @@ func TestServer_ListEnemies(t *testing.T) {
 		assert.Equal(t, test.wantErr, err)
 	}
 }
+
+func TestServer_DeleteEnemy(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.DeleteEnemyRequest
+		storage *storageMock
+		want    *enemy.DeleteEnemyResponse
+		wantErr error
+	}{
+		{
+			name: "Test error from storage",
+			give: &enemy.DeleteEnemyRequest{Id: "enemy1"},
+			storage: &storageMock{
+				deleteEnemy: func(ctx context.Context, req *enemy.DeleteEnemyRequest) (*enemy.DeleteEnemyResponse, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			wantErr: status.Error(codes.Internal, "internal error"),
+		},
+		{
+			name:    "Test empty id",
+			give:    &enemy.DeleteEnemyRequest{},
+			wantErr: status.Error(codes.InvalidArgument, "id can't be empty"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.DeleteEnemyRequest{Id: "enemy1"},
+			storage: &storageMock{
+				deleteEnemy: func(ctx context.Context, req *enemy.DeleteEnemyRequest) (*enemy.DeleteEnemyResponse, error) {
+					return &enemy.DeleteEnemyResponse{
+						Enemy: &enemy.Enemy{
+							Id:          "enemy1",
+							Name:        "Enemy One",
+							Email:       "enemy1@bar.com",
+							Rating:      1.1,
+							LastUpdated: timestamppb.New(time.Date(2021, time.December, 30, 20, 57, 35, 0, time.UTC)),
+							DeletedAt:   timestamppb.New(time.Date(2021, time.December, 31, 10, 12, 45, 0, time.UTC)),
+						},
+					}, nil
+				},
+			},
+			want: &enemy.DeleteEnemyResponse{
+				Enemy: &enemy.Enemy{
+					Id:          "enemy1",
+					Name:        "Enemy One",
+					Email:       "enemy1@bar.com",
+					Rating:      1.1,
+					LastUpdated: timestamppb.New(time.Date(2021, time.December, 30, 20, 57, 35, 0, time.UTC)),
+					DeletedAt:   timestamppb.New(time.Date(2021, time.December, 31, 10, 12, 45, 0, time.UTC)),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		srv := New(test.storage)
+		res, err := srv.DeleteEnemy(context.Background(), test.give)
+		gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		assert.Equal(t, test.wantErr, err)
+	}
+}
+
+func TestServer_UndeleteEnemy(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.UndeleteEnemyRequest
+		storage *storageMock
+		want    *enemy.UndeleteEnemyResponse
+		wantErr error
+	}{
+		{
+			name: "Test error from storage",
+			give: &enemy.UndeleteEnemyRequest{Id: "enemy1"},
+			storage: &storageMock{
+				undeleteEnemy: func(ctx context.Context, req *enemy.UndeleteEnemyRequest) (*enemy.UndeleteEnemyResponse, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			wantErr: status.Error(codes.Internal, "internal error"),
+		},
+		{
+			name:    "Test empty id",
+			give:    &enemy.UndeleteEnemyRequest{},
+			wantErr: status.Error(codes.InvalidArgument, "id can't be empty"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.UndeleteEnemyRequest{Id: "enemy1"},
+			storage: &storageMock{
+				undeleteEnemy: func(ctx context.Context, req *enemy.UndeleteEnemyRequest) (*enemy.UndeleteEnemyResponse, error) {
+					return &enemy.UndeleteEnemyResponse{
+						Enemy: &enemy.Enemy{
+							Id:          "enemy1",
+							Name:        "Enemy One",
+							Email:       "enemy1@bar.com",
+							Rating:      1.1,
+							LastUpdated: timestamppb.New(time.Date(2021, time.December, 30, 20, 57, 35, 0, time.UTC)),
+						},
+					}, nil
+				},
+			},
+			want: &enemy.UndeleteEnemyResponse{
+				Enemy: &enemy.Enemy{
+					Id:          "enemy1",
+					Name:        "Enemy One",
+					Email:       "enemy1@bar.com",
+					Rating:      1.1,
+					LastUpdated: timestamppb.New(time.Date(2021, time.December, 30, 20, 57, 35, 0, time.UTC)),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		srv := New(test.storage)
+		res, err := srv.UndeleteEnemy(context.Background(), test.give)
+		gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		assert.Equal(t, test.wantErr, err)
+	}
+}
+
+func TestServer_PurgeEnemy(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.PurgeEnemyRequest
+		storage *storageMock
+		want    *enemy.PurgeEnemyResponse
+		wantErr error
+	}{
+		{
+			name: "Test error from storage",
+			give: &enemy.PurgeEnemyRequest{Id: "enemy1"},
+			storage: &storageMock{
+				purgeEnemy: func(ctx context.Context, req *enemy.PurgeEnemyRequest) (*enemy.PurgeEnemyResponse, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			wantErr: status.Error(codes.Internal, "internal error"),
+		},
+		{
+			name:    "Test empty id",
+			give:    &enemy.PurgeEnemyRequest{},
+			wantErr: status.Error(codes.InvalidArgument, "id can't be empty"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.PurgeEnemyRequest{Id: "enemy1"},
+			storage: &storageMock{
+				purgeEnemy: func(ctx context.Context, req *enemy.PurgeEnemyRequest) (*enemy.PurgeEnemyResponse, error) {
+					return &enemy.PurgeEnemyResponse{}, nil
+				},
+			},
+			want: &enemy.PurgeEnemyResponse{},
+		},
+	}
+
+	for _, test := range tests {
+		srv := New(test.storage)
+		res, err := srv.PurgeEnemy(context.Background(), test.give)
+		gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		assert.Equal(t, test.wantErr, err)
+	}
+}
+
+func TestServer_GetRatingHistory(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.GetRatingHistoryRequest
+		storage *storageMock
+		want    *enemy.GetRatingHistoryResponse
+		wantErr error
+	}{
+		{
+			name: "Test error from storage",
+			give: &enemy.GetRatingHistoryRequest{Id: "enemy1"},
+			storage: &storageMock{
+				getRatingHistory: func(ctx context.Context, req *enemy.GetRatingHistoryRequest) (*enemy.GetRatingHistoryResponse, error) {
+					return nil, &storage.Error{Kind: storage.ErrNotFound, Msg: "enemy not found"}
+				},
+			},
+			wantErr: status.Error(codes.NotFound, "enemy not found"),
+		},
+		{
+			name:    "Test empty id",
+			give:    &enemy.GetRatingHistoryRequest{},
+			wantErr: status.Error(codes.InvalidArgument, "id can't be empty"),
+		},
+		{
+			name: "Test start after end",
+			give: &enemy.GetRatingHistoryRequest{
+				Id:        "enemy1",
+				StartTime: timestamppb.New(time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC)),
+				EndTime:   timestamppb.New(time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			wantErr: status.Error(codes.InvalidArgument, "start time must be before end time"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.GetRatingHistoryRequest{Id: "enemy1"},
+			storage: &storageMock{
+				getRatingHistory: func(ctx context.Context, req *enemy.GetRatingHistoryRequest) (*enemy.GetRatingHistoryResponse, error) {
+					return &enemy.GetRatingHistoryResponse{
+						Points: []*enemy.RatingPoint{
+							{Rating: 1.1, ChangedAt: timestamppb.New(time.Date(2021, time.December, 28, 15, 15, 12, 0, time.UTC))},
+							{Rating: 2.2, ChangedAt: timestamppb.New(time.Date(2021, time.December, 29, 19, 34, 10, 0, time.UTC))},
+						},
+					}, nil
+				},
+			},
+			want: &enemy.GetRatingHistoryResponse{
+				Points: []*enemy.RatingPoint{
+					{Rating: 1.1, ChangedAt: timestamppb.New(time.Date(2021, time.December, 28, 15, 15, 12, 0, time.UTC))},
+					{Rating: 2.2, ChangedAt: timestamppb.New(time.Date(2021, time.December, 29, 19, 34, 10, 0, time.UTC))},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		srv := New(test.storage)
+		res, err := srv.GetRatingHistory(context.Background(), test.give)
+		gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		assert.Equal(t, test.wantErr, err)
+	}
+}
+
+func TestServer_ListAuditEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.ListAuditEventsRequest
+		storage *storageMock
+		want    *enemy.ListAuditEventsResponse
+		wantErr error
+	}{
+		{
+			name: "Test error from storage",
+			give: &enemy.ListAuditEventsRequest{},
+			storage: &storageMock{
+				listAuditEvents: func(ctx context.Context, req *enemy.ListAuditEventsRequest) (*enemy.ListAuditEventsResponse, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			wantErr: status.Error(codes.Internal, "internal error"),
+		},
+		{
+			name:    "Test negative page size",
+			give:    &enemy.ListAuditEventsRequest{PageSize: -1},
+			wantErr: status.Error(codes.InvalidArgument, "page size can't be negative"),
+		},
+		{
+			name: "Test start after end",
+			give: &enemy.ListAuditEventsRequest{
+				StartTime: timestamppb.New(time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC)),
+				EndTime:   timestamppb.New(time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			wantErr: status.Error(codes.InvalidArgument, "start time must be before end time"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.ListAuditEventsRequest{EnemyId: "enemy1", Actor: "harry"},
+			storage: &storageMock{
+				listAuditEvents: func(ctx context.Context, req *enemy.ListAuditEventsRequest) (*enemy.ListAuditEventsResponse, error) {
+					return &enemy.ListAuditEventsResponse{
+						Events: []*enemy.AuditEvent{
+							{
+								EnemyId: req.GetEnemyId(),
+								Actor:   req.GetActor(),
+								Method:  "/enemy.EnemyService/AddEnemy",
+								After:   &enemy.Enemy{Id: "enemy1", Name: "Enemy One"},
+								Time:    timestamppb.New(time.Date(2021, time.December, 28, 15, 15, 12, 0, time.UTC)),
+							},
+						},
+					}, nil
+				},
+			},
+			want: &enemy.ListAuditEventsResponse{
+				Events: []*enemy.AuditEvent{
+					{
+						EnemyId: "enemy1",
+						Actor:   "harry",
+						Method:  "/enemy.EnemyService/AddEnemy",
+						After:   &enemy.Enemy{Id: "enemy1", Name: "Enemy One"},
+						Time:    timestamppb.New(time.Date(2021, time.December, 28, 15, 15, 12, 0, time.UTC)),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		srv := New(test.storage)
+		res, err := srv.ListAuditEvents(context.Background(), test.give)
+		gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		assert.Equal(t, test.wantErr, err)
+	}
+}
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		give error
+		want error
+	}{
+		{
+			name: "Test not found",
+			give: &storage.Error{Kind: storage.ErrNotFound, Msg: "enemy not found"},
+			want: status.Error(codes.NotFound, "enemy not found"),
+		},
+		{
+			name: "Test conflict",
+			give: &storage.Error{Kind: storage.ErrConflict, Msg: "enemy already exists"},
+			want: status.Error(codes.AlreadyExists, "enemy already exists"),
+		},
+		{
+			name: "Test conflict with id",
+			give: &storage.Error{Kind: storage.ErrConflict, Msg: `email "a@bar.com" is already used by enemy enemy1`, ID: "enemy1"},
+			want: func() error {
+				st, _ := status.New(codes.AlreadyExists, `email "a@bar.com" is already used by enemy enemy1`).WithDetails(&errdetails.ResourceInfo{
+					ResourceType: "enemy",
+					ResourceName: "enemy1",
+				})
+				return st.Err()
+			}(),
+		},
+		{
+			name: "Test unavailable",
+			give: &storage.Error{Kind: storage.ErrUnavailable, Msg: "database unavailable"},
+			want: status.Error(codes.Unavailable, "database unavailable"),
+		},
+		{
+			name: "Test status passed through",
+			give: status.Error(codes.FailedPrecondition, "some precondition"),
+			want: status.Error(codes.FailedPrecondition, "some precondition"),
+		},
+		{
+			name: "Test unknown error hidden",
+			give: errors.New("pq: some driver message"),
+			want: status.Error(codes.Internal, "internal error"),
+		},
+	}
+
+	for _, test := range tests {
+		gotestAssert.DeepEqual(t, status.Convert(test.want).Proto(), status.Convert(toStatus(test.give)).Proto(), protocmp.Transform())
+	}
+}
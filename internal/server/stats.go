@@ -0,0 +1,34 @@
+package server
+
+import (
+	"context"
+	"math"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Most histogram buckets GetStats can be asked for.
+const maxBucketBoundaries = 100
+
+func (s *Server) GetStats(ctx context.Context, req *enemy.GetStatsRequest) (*enemy.GetStatsResponse, error) {
+	// The time window is checked by the storage, as it knows what now is.
+	if len(req.GetBucketBoundaries()) > maxBucketBoundaries {
+		return nil, status.Errorf(codes.InvalidArgument, "can't have more than %d bucket boundaries", maxBucketBoundaries)
+	}
+	boundaries := req.GetBucketBoundaries()
+	for i, b := range boundaries {
+		switch {
+		case math.IsNaN(float64(b)) || math.IsInf(float64(b), 0):
+			return nil, status.Error(codes.InvalidArgument, "bucket boundaries must be finite")
+		case i > 0 && b <= boundaries[i-1]:
+			return nil, status.Error(codes.InvalidArgument, "bucket boundaries must be ascending")
+		}
+	}
+	res, err := s.storage.GetStats(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,75 @@
+package server
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/larwef/rpi-docker-test/internal/storage"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	gotestAssert "gotest.tools/v3/assert"
+)
+
+func TestServer_GetStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.GetStatsRequest
+		storage *storageMock
+		want    *enemy.GetStatsResponse
+		wantErr error
+	}{
+		{
+			name:    "Test too many bucket boundaries",
+			give:    &enemy.GetStatsRequest{BucketBoundaries: make([]float32, maxBucketBoundaries+1)},
+			wantErr: status.Error(codes.InvalidArgument, "can't have more than 100 bucket boundaries"),
+		},
+		{
+			name:    "Test bucket boundaries not ascending",
+			give:    &enemy.GetStatsRequest{BucketBoundaries: []float32{1, 5, 5}},
+			wantErr: status.Error(codes.InvalidArgument, "bucket boundaries must be ascending"),
+		},
+		{
+			name:    "Test bucket boundary NaN",
+			give:    &enemy.GetStatsRequest{BucketBoundaries: []float32{1, float32(math.NaN())}},
+			wantErr: status.Error(codes.InvalidArgument, "bucket boundaries must be finite"),
+		},
+		{
+			name:    "Test bucket boundary infinite",
+			give:    &enemy.GetStatsRequest{BucketBoundaries: []float32{float32(math.Inf(-1)), 1}},
+			wantErr: status.Error(codes.InvalidArgument, "bucket boundaries must be finite"),
+		},
+		{
+			name: "Test time window too long",
+			give: &enemy.GetStatsRequest{},
+			storage: &storageMock{
+				getStats: func(ctx context.Context, req *enemy.GetStatsRequest) (*enemy.GetStatsResponse, error) {
+					return nil, &storage.Error{Kind: storage.ErrInvalidArgument, Msg: "time window can't be longer than 366 days"}
+				},
+			},
+			wantErr: status.Error(codes.InvalidArgument, "time window can't be longer than 366 days"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.GetStatsRequest{BucketBoundaries: []float32{5}},
+			storage: &storageMock{
+				getStats: func(ctx context.Context, req *enemy.GetStatsRequest) (*enemy.GetStatsResponse, error) {
+					return &enemy.GetStatsResponse{Count: 3, BucketBoundaries: req.GetBucketBoundaries(), Histogram: []int32{1, 2}}, nil
+				},
+			},
+			want: &enemy.GetStatsResponse{Count: 3, BucketBoundaries: []float32{5}, Histogram: []int32{1, 2}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(test.storage)
+			res, err := srv.GetStats(context.Background(), test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
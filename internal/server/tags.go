@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Longest tag accepted.
+const maxTagLength = 64
+
+func (s *Server) AddTags(ctx context.Context, req *enemy.AddTagsRequest) (*enemy.AddTagsResponse, error) {
+	tags, err := validateTagChange(req.GetId(), req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+	req.Tags = tags
+	res, err := s.storage.AddTags(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: res.GetEnemy()})
+	return res, nil
+}
+
+func (s *Server) RemoveTags(ctx context.Context, req *enemy.RemoveTagsRequest) (*enemy.RemoveTagsResponse, error) {
+	tags, err := validateTagChange(req.GetId(), req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+	req.Tags = tags
+	res, err := s.storage.RemoveTags(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: res.GetEnemy()})
+	return res, nil
+}
+
+func (s *Server) ListTags(ctx context.Context, req *enemy.ListTagsRequest) (*enemy.ListTagsResponse, error) {
+	res, err := s.storage.ListTags(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return res, nil
+}
+
+func validateTagChange(id string, tags []string) ([]string, error) {
+	switch {
+	case id == "":
+		return nil, status.Error(codes.InvalidArgument, "id can't be empty")
+	case len(tags) == 0:
+		return nil, status.Error(codes.InvalidArgument, "tags can't be empty")
+	}
+	return normalizeTags(tags)
+}
+
+// normalizeTags trims and lowercases tags, so that "Work" and "work " are the
+// same tag, and returns them sorted without duplicates.
+func normalizeTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	seen := make(map[string]bool, len(tags))
+	var res []string
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		switch {
+		case tag == "":
+			return nil, status.Error(codes.InvalidArgument, "tag can't be empty")
+		case len(tag) > maxTagLength:
+			return nil, status.Errorf(codes.InvalidArgument, "tag can't be longer than %d characters", maxTagLength)
+		case seen[tag]:
+			continue
+		}
+		seen[tag] = true
+		res = append(res, tag)
+	}
+	sort.Strings(res)
+	return res, nil
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/larwef/rpi-docker-test/internal/storage"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	gotestAssert "gotest.tools/v3/assert"
+)
+
+func TestServer_AddTags(t *testing.T) {
+	storageMock := &storageMock{
+		addTags: func(ctx context.Context, req *enemy.AddTagsRequest) (*enemy.AddTagsResponse, error) {
+			if req.GetId() != "enemy1" {
+				return nil, &storage.Error{Kind: storage.ErrNotFound, Msg: "enemy not found"}
+			}
+			return &enemy.AddTagsResponse{Enemy: &enemy.Enemy{Id: "enemy1", Tags: req.GetTags()}}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		give    *enemy.AddTagsRequest
+		want    *enemy.AddTagsResponse
+		wantErr error
+	}{
+		{
+			name:    "Test empty id",
+			give:    &enemy.AddTagsRequest{Tags: []string{"work"}},
+			wantErr: status.Error(codes.InvalidArgument, "id can't be empty"),
+		},
+		{
+			name:    "Test no tags",
+			give:    &enemy.AddTagsRequest{Id: "enemy1"},
+			wantErr: status.Error(codes.InvalidArgument, "tags can't be empty"),
+		},
+		{
+			name:    "Test empty tag",
+			give:    &enemy.AddTagsRequest{Id: "enemy1", Tags: []string{"work", ""}},
+			wantErr: status.Error(codes.InvalidArgument, "tag can't be empty"),
+		},
+		{
+			name:    "Test tag too long",
+			give:    &enemy.AddTagsRequest{Id: "enemy1", Tags: []string{strings.Repeat("a", maxTagLength+1)}},
+			wantErr: status.Error(codes.InvalidArgument, "tag can't be longer than 64 characters"),
+		},
+		{
+			name:    "Test not found",
+			give:    &enemy.AddTagsRequest{Id: "enemy2", Tags: []string{"work"}},
+			wantErr: status.Error(codes.NotFound, "enemy not found"),
+		},
+		{
+			name: "Test tags normalized",
+			give: &enemy.AddTagsRequest{Id: "enemy1", Tags: []string{"Work ", "school", "WORK"}},
+			want: &enemy.AddTagsResponse{Enemy: &enemy.Enemy{Id: "enemy1", Tags: []string{"school", "work"}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(storageMock)
+			res, err := srv.AddTags(context.Background(), test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
+
+func TestServer_RemoveTags(t *testing.T) {
+	storageMock := &storageMock{
+		removeTags: func(ctx context.Context, req *enemy.RemoveTagsRequest) (*enemy.RemoveTagsResponse, error) {
+			return &enemy.RemoveTagsResponse{Enemy: &enemy.Enemy{Id: req.GetId()}}, nil
+		},
+	}
+
+	tests := []struct {
+		name    string
+		give    *enemy.RemoveTagsRequest
+		want    *enemy.RemoveTagsResponse
+		wantErr error
+	}{
+		{
+			name:    "Test empty id",
+			give:    &enemy.RemoveTagsRequest{Tags: []string{"work"}},
+			wantErr: status.Error(codes.InvalidArgument, "id can't be empty"),
+		},
+		{
+			name:    "Test no tags",
+			give:    &enemy.RemoveTagsRequest{Id: "enemy1"},
+			wantErr: status.Error(codes.InvalidArgument, "tags can't be empty"),
+		},
+		{
+			name: "Test successful",
+			give: &enemy.RemoveTagsRequest{Id: "enemy1", Tags: []string{"work"}},
+			want: &enemy.RemoveTagsResponse{Enemy: &enemy.Enemy{Id: "enemy1"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(storageMock)
+			res, err := srv.RemoveTags(context.Background(), test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
+
+func TestServer_ListTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage *storageMock
+		want    *enemy.ListTagsResponse
+		wantErr error
+	}{
+		{
+			name: "Test some error",
+			storage: &storageMock{
+				listTags: func(ctx context.Context, req *enemy.ListTagsRequest) (*enemy.ListTagsResponse, error) {
+					return nil, errors.New("some error")
+				},
+			},
+			wantErr: status.Error(codes.Internal, "internal error"),
+		},
+		{
+			name: "Test successful",
+			storage: &storageMock{
+				listTags: func(ctx context.Context, req *enemy.ListTagsRequest) (*enemy.ListTagsResponse, error) {
+					return &enemy.ListTagsResponse{Tags: []*enemy.TagCount{{Tag: "work", Count: 2}}}, nil
+				},
+			},
+			want: &enemy.ListTagsResponse{Tags: []*enemy.TagCount{{Tag: "work", Count: 2}}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(test.storage)
+			res, err := srv.ListTags(context.Background(), &enemy.ListTagsRequest{})
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
@@ -0,0 +1,249 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"sort"
+
+	"github.com/larwef/rpi-docker-test/internal/enemyio"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ExportEnemies sends one chunk per page of enemies.
+func (s *Server) ExportEnemies(req *enemy.ExportEnemiesRequest, stream enemy.EnemyService_ExportEnemiesServer) error {
+	var buf bytes.Buffer
+	w, err := enemyio.NewWriter(&buf, req.GetFormat())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	listReq := &enemy.ListEnemiesRequest{
+		ShowDeleted: req.GetShowDeleted(),
+		PageSize:    maxPageSize,
+		Filter:      req.GetFilter(),
+		OrderBy:     req.GetOrderBy(),
+	}
+	for {
+		res, err := s.ListEnemies(stream.Context(), listReq)
+		if err != nil {
+			return err
+		}
+		for _, enmy := range res.GetEnemies() {
+			if err := w.Write(enmy); err != nil {
+				return toStatus(err)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return toStatus(err)
+		}
+		if err := stream.Send(&enemy.ExportEnemiesResponse{Data: buf.Bytes()}); err != nil {
+			return err
+		}
+		buf.Reset()
+		if res.GetNextPageToken() == "" {
+			return nil
+		}
+		listReq.PageToken = res.GetNextPageToken()
+	}
+}
+
+func (s *Server) ImportEnemies(stream enemy.EnemyService_ImportEnemiesServer) error {
+	first, err := stream.Recv()
+	if err == io.EOF {
+		first = &enemy.ImportEnemiesRequest{}
+	} else if err != nil {
+		return err
+	}
+	r, err := enemyio.NewReader(&chunkReader{buf: first.GetData(), recv: func() ([]byte, error) {
+		req, err := stream.Recv()
+		return req.GetData(), err
+	}}, first.GetFormat())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &enemy.ImportEnemiesResponse{}
+	reject := func(line int, reason string) {
+		res.RejectedRows = append(res.RejectedRows, &enemy.RejectedRow{Line: int32(line), Reason: reason})
+	}
+	// Rows are read as they are imported, so the file is never held in memory.
+	next := func() (*enemy.AddEnemyRequest, int, error) {
+		for {
+			row, err := r.Read()
+			if err == io.EOF {
+				return nil, 0, io.EOF
+			}
+			var lineErr *enemyio.LineError
+			if errors.As(err, &lineErr) {
+				reject(lineErr.Line, lineErr.Msg)
+				continue
+			}
+			if err != nil {
+				if _, ok := status.FromError(err); ok {
+					return nil, 0, err
+				}
+				return nil, 0, status.Errorf(codes.InvalidArgument, "invalid file: %v", err)
+			}
+			// Importing a deleted enemy would bring it back as a live one.
+			if row.Deleted {
+				res.Skipped++
+				continue
+			}
+			req := &enemy.AddEnemyRequest{Name: row.Name, Email: row.Email, Rating: row.Rating}
+			if err := validateAddEnemy(req); err != nil {
+				reject(row.Line, status.Convert(err).Message())
+				continue
+			}
+			res.Valid++
+			return req, row.Line, nil
+		}
+	}
+
+	switch {
+	case first.GetDryRun():
+		err = s.importDryRun(stream.Context(), next, first.GetUpsert(), reject)
+	case first.GetUpsert():
+		err = s.importUpsert(stream.Context(), next, res, reject)
+	default:
+		err = s.importInsert(stream.Context(), next, res, reject)
+	}
+	if err != nil {
+		return err
+	}
+	sort.Slice(res.RejectedRows, func(i, j int) bool {
+		return res.RejectedRows[i].GetLine() < res.RejectedRows[j].GetLine()
+	})
+	return stream.SendAndClose(res)
+}
+
+// importRows returns the next valid row of an import and the line it is on,
+// rejecting invalid rows on the way.
+type importRows func() (*enemy.AddEnemyRequest, int, error)
+
+// importDryRun imports the rows in a transaction that is rolled back, to
+// reject the rows the import would, like those conflicting with other enemies.
+func (s *Server) importDryRun(ctx context.Context, next importRows, upsert bool, reject func(line int, reason string)) error {
+	// Rows of the dry run count the valid rows only.
+	var lines []int
+	nextRow := func() (*enemy.AddEnemyRequest, error) {
+		req, line, err := next()
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+		return req, nil
+	}
+	var rejected []*enemy.RejectedRow
+	if upsert {
+		bulkRes, err := s.storage.DryRunBulkLoadEnemies(ctx, func() (*enemy.BulkLoadEnemiesRequest, error) {
+			req, err := nextRow()
+			if err != nil {
+				return nil, err
+			}
+			return &enemy.BulkLoadEnemiesRequest{Name: req.GetName(), Email: req.GetEmail(), Rating: req.GetRating()}, nil
+		})
+		if err != nil {
+			return toStatus(err)
+		}
+		rejected = bulkRes.GetRejectedRows()
+	} else {
+		var err error
+		if rejected, err = s.storage.DryRunAddEnemies(ctx, nextRow); err != nil {
+			return toStatus(err)
+		}
+	}
+	for _, row := range rejected {
+		reject(lines[row.GetLine()-1], row.GetReason())
+	}
+	return nil
+}
+
+// importInsert adds the rows in batches of at most the max batch size, so
+// large files don't have to fit in memory.
+func (s *Server) importInsert(ctx context.Context, next importRows, res *enemy.ImportEnemiesResponse, reject func(line int, reason string)) error {
+	var reqs []*enemy.AddEnemyRequest
+	var lines []int
+	flush := func() error {
+		results, err := s.storage.BatchAddEnemies(ctx, reqs, false)
+		if err != nil {
+			return toStatus(err)
+		}
+		for i, result := range results {
+			if result.Err != nil {
+				reject(lines[i], status.Convert(toStatus(result.Err)).Message())
+				continue
+			}
+			res.Inserted++
+			s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_ADDED, Enemy: result.Enemy})
+		}
+		reqs, lines = reqs[:0], lines[:0]
+		return nil
+	}
+	for {
+		req, line, err := next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		reqs = append(reqs, req)
+		lines = append(lines, line)
+		if len(reqs) == s.maxBatchSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(reqs) == 0 {
+		return nil
+	}
+	return flush()
+}
+
+// importUpsert streams the rows to a bulk load.
+func (s *Server) importUpsert(ctx context.Context, next importRows, res *enemy.ImportEnemiesResponse, reject func(line int, reason string)) error {
+	// Lines of the bulk load count the valid rows only.
+	var lines []int
+	bulkRes, events, err := s.storage.BulkLoadEnemies(ctx, func() (*enemy.BulkLoadEnemiesRequest, error) {
+		req, line, err := next()
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, line)
+		return &enemy.BulkLoadEnemiesRequest{Name: req.GetName(), Email: req.GetEmail(), Rating: req.GetRating()}, nil
+	})
+	if err != nil {
+		return toStatus(err)
+	}
+	res.Inserted, res.Updated = bulkRes.GetInserted(), bulkRes.GetUpdated()
+	for _, ev := range events {
+		s.events.publish(ev)
+	}
+	for _, rejected := range bulkRes.GetRejectedRows() {
+		reject(lines[rejected.GetLine()-1], rejected.GetReason())
+	}
+	return nil
+}
+
+// chunkReader reads the data of a stream of chunks.
+type chunkReader struct {
+	buf  []byte
+	recv func() ([]byte, error)
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		data, err := r.recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
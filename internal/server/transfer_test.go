@@ -0,0 +1,266 @@
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/internal/storage"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	gotestAssert "gotest.tools/v3/assert"
+)
+
+// exportStream collects the chunks sent on it.
+type exportStream struct {
+	grpc.ServerStream
+	data []byte
+}
+
+func (s *exportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *exportStream) Send(res *enemy.ExportEnemiesResponse) error {
+	s.data = append(s.data, res.GetData()...)
+	return nil
+}
+
+// importStream replays reqs and keeps the response.
+type importStream struct {
+	grpc.ServerStream
+	reqs []*enemy.ImportEnemiesRequest
+	res  *enemy.ImportEnemiesResponse
+}
+
+func (s *importStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *importStream) Recv() (*enemy.ImportEnemiesRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+	return req, nil
+}
+
+func (s *importStream) SendAndClose(res *enemy.ImportEnemiesResponse) error {
+	s.res = res
+	return nil
+}
+
+func TestServer_ExportEnemies(t *testing.T) {
+	srv := New(&storageMock{
+		listEnemies: func(ctx context.Context, req *enemy.ListEnemiesRequest) (*enemy.ListEnemiesResponse, error) {
+			lastUpdated := timestamppb.New(time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC))
+			if req.GetPageToken() == "" {
+				return &enemy.ListEnemiesResponse{
+					Enemies:       []*enemy.Enemy{{Id: "enemy1", Name: "Voldemort", Email: "voldemort@bar.com", Rating: 9.9, LastUpdated: lastUpdated}},
+					NextPageToken: "page2",
+				}, nil
+			}
+			return &enemy.ListEnemiesResponse{
+				Enemies: []*enemy.Enemy{{Id: "enemy2", Name: "Draco", Email: "draco@bar.com", Rating: 5.5, LastUpdated: lastUpdated}},
+			}, nil
+		},
+	})
+	stream := &exportStream{}
+	err := srv.ExportEnemies(&enemy.ExportEnemiesRequest{Format: enemy.Format_CSV}, stream)
+	assert.NoError(t, err)
+	assert.Equal(t, "id,name,email,rating,lastUpdated,deletedAt\n"+
+		"enemy1,Voldemort,voldemort@bar.com,9.9,2021-12-30T14:59:45Z,\n"+
+		"enemy2,Draco,draco@bar.com,5.5,2021-12-30T14:59:45Z,\n", string(stream.data))
+}
+
+func TestServer_ImportEnemies(t *testing.T) {
+	// The file is split mid line to make sure chunks are joined.
+	chunks := []*enemy.ImportEnemiesRequest{
+		{Data: []byte("name,email,rating\nVoldemort,voldemort@bar.com,9.9\nDra")},
+		{Data: []byte("co,draco@bar.com,5.5\nNameless,nameless@bar.com,1\n,empty@bar.com,1\n")},
+	}
+	storageMock := &storageMock{
+		batchAddEnemies: func(ctx context.Context, reqs []*enemy.AddEnemyRequest, atomic bool) ([]storage.BatchResult, error) {
+			var res []storage.BatchResult
+			for _, req := range reqs {
+				if req.GetEmail() == "draco@bar.com" {
+					res = append(res, storage.BatchResult{Err: &storage.Error{Kind: storage.ErrConflict, Msg: "enemy already exists"}})
+					continue
+				}
+				res = append(res, storage.BatchResult{Enemy: &enemy.Enemy{Name: req.GetName()}})
+			}
+			return res, nil
+		},
+		bulkLoadEnemies: func(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, []*enemy.EnemyEvent, error) {
+			res := &enemy.BulkLoadEnemiesResponse{}
+			var events []*enemy.EnemyEvent
+			for {
+				row, err := next()
+				if err == io.EOF {
+					return res, events, nil
+				}
+				typ := enemy.EnemyEvent_ADDED
+				if row.GetEmail() == "draco@bar.com" {
+					res.Updated++
+					typ = enemy.EnemyEvent_UPDATED
+				} else {
+					res.Inserted++
+				}
+				events = append(events, &enemy.EnemyEvent{Type: typ, Enemy: &enemy.Enemy{Name: row.GetName()}})
+			}
+		},
+		dryRunAddEnemies: func(ctx context.Context, next func() (*enemy.AddEnemyRequest, error)) ([]*enemy.RejectedRow, error) {
+			var rejected []*enemy.RejectedRow
+			for line := int32(1); ; line++ {
+				req, err := next()
+				if err == io.EOF {
+					return rejected, nil
+				}
+				if req.GetEmail() == "draco@bar.com" {
+					rejected = append(rejected, &enemy.RejectedRow{Line: line, Reason: "enemy already exists"})
+				}
+			}
+		},
+		dryRunBulkLoadEnemies: func(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, error) {
+			res := &enemy.BulkLoadEnemiesResponse{}
+			for {
+				if _, err := next(); err == io.EOF {
+					return res, nil
+				}
+				res.Inserted++
+			}
+		},
+	}
+
+	tests := []struct {
+		name string
+		opts *enemy.ImportEnemiesRequest
+		want *enemy.ImportEnemiesResponse
+		// Number of events published to watchers.
+		wantEvents int
+	}{
+		{
+			name: "Test dry run",
+			opts: &enemy.ImportEnemiesRequest{DryRun: true},
+			want: &enemy.ImportEnemiesResponse{
+				Valid: 3,
+				RejectedRows: []*enemy.RejectedRow{
+					{Line: 3, Reason: "enemy already exists"},
+					{Line: 5, Reason: "enemy name can't be empty"},
+				},
+			},
+		},
+		{
+			name: "Test upsert dry run",
+			opts: &enemy.ImportEnemiesRequest{Upsert: true, DryRun: true},
+			want: &enemy.ImportEnemiesResponse{
+				Valid:        3,
+				RejectedRows: []*enemy.RejectedRow{{Line: 5, Reason: "enemy name can't be empty"}},
+			},
+		},
+		{
+			name: "Test insert",
+			opts: &enemy.ImportEnemiesRequest{},
+			want: &enemy.ImportEnemiesResponse{
+				Inserted: 2,
+				Valid:    3,
+				RejectedRows: []*enemy.RejectedRow{
+					{Line: 3, Reason: "enemy already exists"},
+					{Line: 5, Reason: "enemy name can't be empty"},
+				},
+			},
+			wantEvents: 2,
+		},
+		{
+			name: "Test upsert",
+			opts: &enemy.ImportEnemiesRequest{Upsert: true},
+			want: &enemy.ImportEnemiesResponse{
+				Inserted:     2,
+				Updated:      1,
+				Valid:        3,
+				RejectedRows: []*enemy.RejectedRow{{Line: 5, Reason: "enemy name can't be empty"}},
+			},
+			wantEvents: 3,
+		},
+	}
+
+	for _, test := range tests {
+		srv := New(storageMock)
+		first := &enemy.ImportEnemiesRequest{
+			Data:   chunks[0].GetData(),
+			Format: enemy.Format_CSV,
+			Upsert: test.opts.GetUpsert(),
+			DryRun: test.opts.GetDryRun(),
+		}
+		stream := &importStream{reqs: []*enemy.ImportEnemiesRequest{first, chunks[1]}}
+		err := srv.ImportEnemies(stream)
+		assert.NoError(t, err, test.name)
+		gotestAssert.DeepEqual(t, test.want, stream.res, protocmp.Transform())
+		assert.Len(t, srv.events.history, test.wantEvents, test.name)
+	}
+}
+
+func TestServer_ImportEnemies_Deleted(t *testing.T) {
+	var imported []string
+	srv := New(&storageMock{
+		bulkLoadEnemies: func(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, []*enemy.EnemyEvent, error) {
+			res := &enemy.BulkLoadEnemiesResponse{}
+			for {
+				row, err := next()
+				if err == io.EOF {
+					return res, nil, nil
+				}
+				imported = append(imported, row.GetName())
+				res.Updated++
+			}
+		},
+	})
+	// An export with deleted enemies shown.
+	stream := &importStream{reqs: []*enemy.ImportEnemiesRequest{{
+		Data: []byte("id,name,email,rating,lastUpdated,deletedAt\n" +
+			"enemy1,Voldemort,voldemort@bar.com,9.9,2021-12-30T14:59:45Z,\n" +
+			"enemy2,Draco,draco@bar.com,5.5,2021-12-30T14:59:45Z,2021-12-31T14:59:45Z\n"),
+		Upsert: true,
+	}}}
+	err := srv.ImportEnemies(stream)
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.ImportEnemiesResponse{
+		Updated: 1,
+		Valid:   1,
+		Skipped: 1,
+	}, stream.res, protocmp.Transform())
+	assert.Equal(t, []string{"Voldemort"}, imported)
+}
+
+func TestServer_ImportEnemies_Batches(t *testing.T) {
+	var batches [][]string
+	srv := New(&storageMock{
+		batchAddEnemies: func(ctx context.Context, reqs []*enemy.AddEnemyRequest, atomic bool) ([]storage.BatchResult, error) {
+			var names []string
+			var res []storage.BatchResult
+			for _, req := range reqs {
+				names = append(names, req.GetName())
+				res = append(res, storage.BatchResult{Enemy: &enemy.Enemy{Name: req.GetName()}})
+			}
+			batches = append(batches, names)
+			return res, nil
+		},
+	}, WithMaxBatchSize(2))
+	stream := &importStream{reqs: []*enemy.ImportEnemiesRequest{{
+		Data: []byte("name,email,rating\nVoldemort,voldemort@bar.com,9.9\n,empty@bar.com,1\n" +
+			"Draco,draco@bar.com,5.5\nBellatrix,bellatrix@bar.com,8\n"),
+	}}}
+	err := srv.ImportEnemies(stream)
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.ImportEnemiesResponse{
+		Inserted:     3,
+		Valid:        3,
+		RejectedRows: []*enemy.RejectedRow{{Line: 3, Reason: "enemy name can't be empty"}},
+	}, stream.res, protocmp.Transform())
+	assert.Equal(t, [][]string{{"Voldemort", "Draco"}, {"Bellatrix"}}, batches)
+}
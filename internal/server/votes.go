@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+
+	"github.com/larwef/rpi-docker-test/internal/storage"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *Server) CastVote(ctx context.Context, req *enemy.CastVoteRequest) (*enemy.CastVoteResponse, error) {
+	if req.GetEnemyId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "enemy id can't be empty")
+	}
+	if err := storage.ValidateRating(req.GetRating()); err != nil {
+		return nil, toStatus(err)
+	}
+	// Votes are per user, so anonymous votes can't be told apart.
+	if info, _ := storage.AuditInfoFrom(ctx); info.Actor == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "%s metadata is required to vote", actorHeader)
+	}
+	res, err := s.storage.CastVote(ctx, req)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	// The vote of the caller is theirs only.
+	event := proto.Clone(res.GetEnemy()).(*enemy.Enemy)
+	event.MyVote = 0
+	s.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: event})
+	return res, nil
+}
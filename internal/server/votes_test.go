@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/larwef/rpi-docker-test/internal/storage"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	gotestAssert "gotest.tools/v3/assert"
+)
+
+func TestServer_CastVote(t *testing.T) {
+	storageMock := &storageMock{
+		castVote: func(ctx context.Context, req *enemy.CastVoteRequest) (*enemy.CastVoteResponse, error) {
+			return &enemy.CastVoteResponse{
+				Enemy: &enemy.Enemy{Id: req.GetEnemyId(), VoteRating: 4, VoteCount: 2, MyVote: req.GetRating()},
+			}, nil
+		},
+	}
+	withActor := storage.WithAuditInfo(context.Background(), storage.AuditInfo{Actor: "someone"})
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		give    *enemy.CastVoteRequest
+		want    *enemy.CastVoteResponse
+		wantErr error
+	}{
+		{
+			name:    "Test empty enemy id",
+			ctx:     withActor,
+			give:    &enemy.CastVoteRequest{Rating: 3},
+			wantErr: status.Error(codes.InvalidArgument, "enemy id can't be empty"),
+		},
+		{
+			name:    "Test rating not positive",
+			ctx:     withActor,
+			give:    &enemy.CastVoteRequest{EnemyId: "enemy1"},
+			wantErr: status.Error(codes.InvalidArgument, "rating must be > 0"),
+		},
+		{
+			name:    "Test rating NaN",
+			ctx:     withActor,
+			give:    &enemy.CastVoteRequest{EnemyId: "enemy1", Rating: float32(math.NaN())},
+			wantErr: status.Error(codes.InvalidArgument, "rating must be finite"),
+		},
+		{
+			name:    "Test rating infinite",
+			ctx:     withActor,
+			give:    &enemy.CastVoteRequest{EnemyId: "enemy1", Rating: float32(math.Inf(1))},
+			wantErr: status.Error(codes.InvalidArgument, "rating must be finite"),
+		},
+		{
+			name:    "Test rating negative infinite",
+			ctx:     withActor,
+			give:    &enemy.CastVoteRequest{EnemyId: "enemy1", Rating: float32(math.Inf(-1))},
+			wantErr: status.Error(codes.InvalidArgument, "rating must be finite"),
+		},
+		{
+			name:    "Test no actor",
+			ctx:     context.Background(),
+			give:    &enemy.CastVoteRequest{EnemyId: "enemy1", Rating: 3},
+			wantErr: status.Error(codes.Unauthenticated, "actor metadata is required to vote"),
+		},
+		{
+			name: "Test successful",
+			ctx:  withActor,
+			give: &enemy.CastVoteRequest{EnemyId: "enemy1", Rating: 3},
+			want: &enemy.CastVoteResponse{
+				Enemy: &enemy.Enemy{Id: "enemy1", VoteRating: 4, VoteCount: 2, MyVote: 3},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			srv := New(storageMock)
+			res, err := srv.CastVote(test.ctx, test.give)
+			assert.Equal(t, test.wantErr, err)
+			gotestAssert.DeepEqual(t, test.want, res, protocmp.Transform())
+		})
+	}
+}
+
+func TestServer_CastVote_Event(t *testing.T) {
+	srv := New(&storageMock{
+		castVote: func(ctx context.Context, req *enemy.CastVoteRequest) (*enemy.CastVoteResponse, error) {
+			return &enemy.CastVoteResponse{Enemy: &enemy.Enemy{Id: req.GetEnemyId(), VoteCount: 1, MyVote: req.GetRating()}}, nil
+		},
+	})
+	_, sub, err := srv.events.subscribe("")
+	assert.NoError(t, err)
+	defer srv.events.unsubscribe(sub)
+
+	ctx := storage.WithAuditInfo(context.Background(), storage.AuditInfo{Actor: "someone"})
+	res, err := srv.CastVote(ctx, &enemy.CastVoteRequest{EnemyId: "enemy1", Rating: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(3), res.GetEnemy().GetMyVote())
+
+	// Other watchers shouldn't see the vote of the caller.
+	ev := <-sub
+	gotestAssert.DeepEqual(t, &enemy.Enemy{Id: "enemy1", VoteCount: 1}, ev.GetEnemy(), protocmp.Transform())
+}
@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/internal/filter"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Number of past events kept so that watches can be resumed.
+const watchHistorySize = 1000
+
+// Number of events buffered for a watcher. A watcher falling further behind
+// is dropped, and has to resume.
+const watchBufferSize = 100
+
+// broker fans out enemy events to the WatchEnemies streams of this server.
+type broker struct {
+	mu sync.Mutex
+	// Identifies this broker in resume tokens, so that tokens from before a
+	// restart, another replica or the other feed aren't mistaken for current
+	// ones.
+	epoch int64
+	// Sequence number of the last event.
+	seq     uint64
+	history []*enemy.EnemyEvent
+	subs    map[chan *enemy.EnemyEvent]bool
+}
+
+// Number of brokers started, which keeps the epochs of brokers started at the
+// same time apart.
+var brokers int64
+
+func newBroker() *broker {
+	return &broker{
+		epoch: time.Now().UnixNano() + atomic.AddInt64(&brokers, 1),
+		subs:  map[chan *enemy.EnemyEvent]bool{},
+	}
+}
+
+type resumeToken struct {
+	Epoch int64  `json:"epoch"`
+	Seq   uint64 `json:"seq"`
+}
+
+// publish sends ev to all subscribers, setting its resume token. Its time is
+// set to now unless already set.
+func (b *broker) publish(ev *enemy.EnemyEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.seq++
+	token, _ := json.Marshal(resumeToken{Epoch: b.epoch, Seq: b.seq})
+	ev.ResumeToken = base64.RawURLEncoding.EncodeToString(token)
+	if ev.Time == nil {
+		ev.Time = timestamppb.Now()
+	}
+	b.history = append(b.history, ev)
+	if len(b.history) > watchHistorySize {
+		b.history = b.history[1:]
+	}
+	for ch := range b.subs {
+		select {
+		case ch <- ev:
+		default:
+			delete(b.subs, ch)
+			close(ch)
+		}
+	}
+}
+
+// subscribe returns the events after the one with the given resume token, and
+// a channel receiving the events after those. The channel is closed if the
+// subscriber falls behind. Tokens that can't be resumed from fail with
+// OutOfRange rather than silently skipping events.
+func (b *broker) subscribe(token string) ([]*enemy.EnemyEvent, chan *enemy.EnemyEvent, error) {
+	var after *resumeToken
+	if token != "" {
+		raw, err := base64.RawURLEncoding.DecodeString(token)
+		if err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid resume token")
+		}
+		after = &resumeToken{}
+		if err := json.Unmarshal(raw, after); err != nil {
+			return nil, nil, status.Error(codes.InvalidArgument, "invalid resume token")
+		}
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var replay []*enemy.EnemyEvent
+	if after != nil {
+		oldest := b.seq - uint64(len(b.history))
+		if after.Epoch != b.epoch || after.Seq < oldest || after.Seq > b.seq {
+			return nil, nil, status.Error(codes.OutOfRange, "resume token has expired, list enemies and start a new watch")
+		}
+		replay = append(replay, b.history[after.Seq-oldest:]...)
+	}
+	ch := make(chan *enemy.EnemyEvent, watchBufferSize)
+	b.subs[ch] = true
+	return replay, ch, nil
+}
+
+func (b *broker) unsubscribe(ch chan *enemy.EnemyEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.subs, ch)
+}
+
+// eventStream is the server side of WatchEnemies and SubscribeChanges.
+type eventStream interface {
+	Send(*enemy.EnemyEvent) error
+	Context() context.Context
+}
+
+func (s *Server) WatchEnemies(req *enemy.WatchEnemiesRequest, stream enemy.EnemyService_WatchEnemiesServer) error {
+	return watch(s.events, req.GetFilter(), req.GetResumeToken(), stream)
+}
+
+// SubscribeChanges is like WatchEnemies, but also gets the changes made through
+// other replicas. The changes come from PublishChanges.
+func (s *Server) SubscribeChanges(req *enemy.SubscribeChangesRequest, stream enemy.EnemyService_SubscribeChangesServer) error {
+	return watch(s.changes, req.GetFilter(), req.GetResumeToken(), stream)
+}
+
+// PublishChanges sends the changes from ch to SubscribeChanges streams until
+// ch is closed. The changes are typically from a storage.ChangeListener.
+func (s *Server) PublishChanges(ch <-chan *enemy.EnemyEvent) {
+	for ev := range ch {
+		s.changes.publish(ev)
+	}
+}
+
+// watch streams the events from b matching filter to stream, starting after
+// the one with the given resume token.
+func watch(b *broker, filterStr, token string, stream eventStream) error {
+	expr, err := filter.Parse(filterStr)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+	}
+	replay, events, err := b.subscribe(token)
+	if err != nil {
+		return err
+	}
+	defer b.unsubscribe(events)
+
+	send := func(ev *enemy.EnemyEvent) error {
+		if !filter.Match(expr, enemyFields(ev.GetEnemy())) {
+			return nil
+		}
+		return stream.Send(ev)
+	}
+	for _, ev := range replay {
+		if err := send(ev); err != nil {
+			return err
+		}
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return status.FromContextError(stream.Context().Err()).Err()
+		case ev, ok := <-events:
+			if !ok {
+				return status.Error(codes.Aborted, "watch fell behind, resume it with the last resume token")
+			}
+			if err := send(ev); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// enemyFields gives the values of the filterable fields of e.
+func enemyFields(e *enemy.Enemy) func(filter.Field) interface{} {
+	return func(f filter.Field) interface{} {
+		switch f {
+		case filter.FieldName:
+			return e.GetName()
+		case filter.FieldEmail:
+			return e.GetEmail()
+		case filter.FieldRating:
+			return e.GetRating()
+		case filter.FieldLastUpdated:
+			return e.GetLastUpdated().AsTime()
+		default:
+			return nil
+		}
+	}
+}
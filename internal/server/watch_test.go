@@ -0,0 +1,201 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+	gotestAssert "gotest.tools/v3/assert"
+)
+
+// watchStream collects the events sent on it, and cancels its context once
+// it has received want events.
+type watchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	want   int
+	events []*enemy.EnemyEvent
+}
+
+func newWatchStream(want int) *watchStream {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	return &watchStream{ctx: ctx, cancel: cancel, want: want}
+}
+
+func (s *watchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchStream) Send(ev *enemy.EnemyEvent) error {
+	s.events = append(s.events, ev)
+	if len(s.events) == s.want {
+		s.cancel()
+	}
+	return nil
+}
+
+func TestServer_WatchEnemies_Resume(t *testing.T) {
+	srv := New(&storageMock{
+		updateEnemy: func(ctx context.Context, req *enemy.UpdateEnemyRequest) (*enemy.UpdateEnemyResponse, error) {
+			return &enemy.UpdateEnemyResponse{Enemy: &enemy.Enemy{Id: req.GetId(), Rating: req.GetRating()}}, nil
+		},
+		deleteEnemy: func(ctx context.Context, req *enemy.DeleteEnemyRequest) (*enemy.DeleteEnemyResponse, error) {
+			return &enemy.DeleteEnemyResponse{Enemy: &enemy.Enemy{Id: req.GetId(), Rating: 9}}, nil
+		},
+	})
+	for _, rating := range []float32{1, 8, 2} {
+		_, err := srv.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemy1", Rating: rating})
+		assert.NoError(t, err)
+	}
+	_, err := srv.DeleteEnemy(context.Background(), &enemy.DeleteEnemyRequest{Id: "enemy1"})
+	assert.NoError(t, err)
+
+	// Resuming after the first event, only getting the highly rated ones.
+	stream := newWatchStream(2)
+	err = srv.WatchEnemies(&enemy.WatchEnemiesRequest{
+		Filter:      "rating > 5",
+		ResumeToken: srv.events.history[0].GetResumeToken(),
+	}, stream)
+	assert.Equal(t, status.Error(codes.Canceled, context.Canceled.Error()), err)
+	assert.Len(t, stream.events, 2)
+	for i, ev := range stream.events {
+		// Events don't need to be compared with their resume tokens and times.
+		stream.events[i] = &enemy.EnemyEvent{Type: ev.GetType(), Enemy: ev.GetEnemy()}
+	}
+	gotestAssert.DeepEqual(t, []*enemy.EnemyEvent{
+		{Type: enemy.EnemyEvent_UPDATED, Enemy: &enemy.Enemy{Id: "enemy1", Rating: 8}},
+		{Type: enemy.EnemyEvent_DELETED, Enemy: &enemy.Enemy{Id: "enemy1", Rating: 9}},
+	}, stream.events, protocmp.Transform())
+}
+
+func TestServer_WatchEnemies_Live(t *testing.T) {
+	srv := New(&storageMock{
+		addEnemy: func(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error) {
+			return &enemy.AddEnemyResponse{Enemy: &enemy.Enemy{Id: "enemy1", Name: req.GetName()}}, false, nil
+		},
+	})
+	stream := newWatchStream(1)
+	done := make(chan error)
+	go func() {
+		done <- srv.WatchEnemies(&enemy.WatchEnemiesRequest{}, stream)
+	}()
+	// Wait for the watch to subscribe before adding the enemy.
+	for {
+		srv.events.mu.Lock()
+		n := len(srv.events.subs)
+		srv.events.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	_, err := srv.AddEnemy(context.Background(), &enemy.AddEnemyRequest{Name: "Voldemort", Email: "voldemort@bar.com", Rating: 1})
+	assert.NoError(t, err)
+
+	assert.Equal(t, status.Error(codes.Canceled, context.Canceled.Error()), <-done)
+	assert.Len(t, stream.events, 1)
+	assert.Equal(t, enemy.EnemyEvent_ADDED, stream.events[0].GetType())
+	assert.Equal(t, "Voldemort", stream.events[0].GetEnemy().GetName())
+}
+
+func TestServer_WatchEnemies_InvalidRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		give    *enemy.WatchEnemiesRequest
+		wantErr error
+	}{
+		{
+			name:    "Test invalid filter",
+			give:    &enemy.WatchEnemiesRequest{Filter: "age > 3"},
+			wantErr: status.Error(codes.InvalidArgument, `invalid filter: unknown field "age" at position 1`),
+		},
+		{
+			name:    "Test invalid resume token",
+			give:    &enemy.WatchEnemiesRequest{ResumeToken: "not a token"},
+			wantErr: status.Error(codes.InvalidArgument, "invalid resume token"),
+		},
+		{
+			name:    "Test resume token from before restart",
+			give:    &enemy.WatchEnemiesRequest{ResumeToken: "eyJlcG9jaCI6MSwic2VxIjoxfQ"},
+			wantErr: status.Error(codes.OutOfRange, "resume token has expired, list enemies and start a new watch"),
+		},
+	}
+
+	for _, test := range tests {
+		srv := New(&storageMock{})
+		err := srv.WatchEnemies(test.give, newWatchStream(1))
+		assert.Equal(t, test.wantErr, err, test.name)
+	}
+}
+
+func TestServer_SubscribeChanges(t *testing.T) {
+	srv := New(&storageMock{})
+	ch := make(chan *enemy.EnemyEvent, 3)
+	ch <- &enemy.EnemyEvent{Type: enemy.EnemyEvent_ADDED, Enemy: &enemy.Enemy{Id: "enemy1", Rating: 1}}
+	ch <- &enemy.EnemyEvent{Type: enemy.EnemyEvent_ADDED, Enemy: &enemy.Enemy{Id: "enemy2", Rating: 2}}
+	ch <- &enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: &enemy.Enemy{Id: "enemy1", Rating: 3}}
+	close(ch)
+	srv.PublishChanges(ch)
+
+	stream := newWatchStream(1)
+	err := srv.SubscribeChanges(&enemy.SubscribeChangesRequest{
+		Filter:      "rating != 2",
+		ResumeToken: srv.changes.history[0].GetResumeToken(),
+	}, stream)
+	assert.Equal(t, status.Error(codes.Canceled, context.Canceled.Error()), err)
+	assert.Len(t, stream.events, 1)
+	assert.Equal(t, enemy.EnemyEvent_UPDATED, stream.events[0].GetType())
+	assert.Equal(t, "enemy1", stream.events[0].GetEnemy().GetId())
+
+	// Changes from other replicas don't show up in WatchEnemies.
+	assert.Empty(t, srv.events.history)
+}
+
+func TestServer_WatchEnemies_ExpiredResumeToken(t *testing.T) {
+	srv := New(&storageMock{})
+	for i := 0; i <= watchHistorySize; i++ {
+		srv.events.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: &enemy.Enemy{Id: "enemy1"}})
+	}
+	srv.changes.publish(&enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: &enemy.Enemy{Id: "enemy1"}})
+	wantErr := status.Error(codes.OutOfRange, "resume token has expired, list enemies and start a new watch")
+
+	// Events after the oldest one kept can be resumed, but the first event
+	// has been dropped from the history.
+	err := srv.WatchEnemies(&enemy.WatchEnemiesRequest{ResumeToken: srv.events.history[0].GetResumeToken()}, newWatchStream(1))
+	assert.Equal(t, status.Error(codes.Canceled, context.Canceled.Error()), err)
+	first, err := json.Marshal(resumeToken{Epoch: srv.events.epoch, Seq: 0})
+	assert.NoError(t, err)
+	err = srv.WatchEnemies(&enemy.WatchEnemiesRequest{ResumeToken: base64.RawURLEncoding.EncodeToString(first)}, newWatchStream(1))
+	assert.Equal(t, wantErr, err)
+
+	// Tokens from the other feed, like tokens from another replica, can't be
+	// resumed from.
+	err = srv.SubscribeChanges(&enemy.SubscribeChangesRequest{ResumeToken: srv.events.history[0].GetResumeToken()}, newWatchStream(1))
+	assert.Equal(t, wantErr, err)
+}
+
+func TestServer_AddEnemy_ReplayNotPublished(t *testing.T) {
+	srv := New(&storageMock{
+		addEnemy: func(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error) {
+			return &enemy.AddEnemyResponse{Enemy: &enemy.Enemy{Id: "enemy1"}}, true, nil
+		},
+	})
+	res, err := srv.AddEnemy(context.Background(), &enemy.AddEnemyRequest{
+		Name:           "Voldemort",
+		Email:          "voldemort@bar.com",
+		Rating:         1,
+		IdempotencyKey: "someKey",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "enemy1", res.GetEnemy().GetId())
+	assert.Empty(t, srv.events.history)
+}
@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Array parameters are passed as Postgres array literals in text, and cast to
+// the array type in the query. That keeps the queries independent of how the
+// driver encodes Go slices.
+
+var arrayElemEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// textArray returns the text[] literal holding strs.
+func textArray(strs []string) string {
+	elems := make([]string, len(strs))
+	for i, s := range strs {
+		elems[i] = `"` + arrayElemEscaper.Replace(s) + `"`
+	}
+	return "{" + strings.Join(elems, ",") + "}"
+}
+
+// intArray returns the integer[] literal holding ints.
+func intArray(ints []int32) string {
+	elems := make([]string, len(ints))
+	for i, n := range ints {
+		elems[i] = strconv.FormatInt(int64(n), 10)
+	}
+	return "{" + strings.Join(elems, ",") + "}"
+}
+
+// realArray returns the real[] literal holding fs.
+func realArray(fs []float32) string {
+	elems := make([]string, len(fs))
+	for i, f := range fs {
+		elems[i] = strconv.FormatFloat(float64(f), 'g', -1, 32)
+	}
+	return "{" + strings.Join(elems, ",") + "}"
+}
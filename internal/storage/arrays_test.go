@@ -0,0 +1,16 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrays(t *testing.T) {
+	assert.Equal(t, "{}", textArray(nil))
+	assert.Equal(t, `{"a","b c","{x,y}","say \"hi\"","back\\slash"}`, textArray([]string{"a", "b c", "{x,y}", `say "hi"`, `back\slash`}))
+	assert.Equal(t, "{}", intArray(nil))
+	assert.Equal(t, "{1,-2,30}", intArray([]int32{1, -2, 30}))
+	assert.Equal(t, "{}", realArray(nil))
+	assert.Equal(t, "{0.5,2,1e+10}", realArray([]float32{0.5, 2, 1e10}))
+}
@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AuditInfo describes the call making a change, for the audit log.
+type AuditInfo struct {
+	Actor  string
+	Method string
+	Peer   string
+}
+
+type auditInfoKey struct{}
+
+// WithAuditInfo returns a context carrying info, which is recorded with any
+// change made using the context.
+func WithAuditInfo(ctx context.Context, info AuditInfo) context.Context {
+	return context.WithValue(ctx, auditInfoKey{}, info)
+}
+
+// AuditInfoFrom returns the info set with WithAuditInfo, if any.
+func AuditInfoFrom(ctx context.Context) (AuditInfo, bool) {
+	info, ok := ctx.Value(auditInfoKey{}).(AuditInfo)
+	return info, ok
+}
+
+func auditInfoFrom(ctx context.Context, method string) AuditInfo {
+	info, _ := AuditInfoFrom(ctx)
+	if info.Actor == "" {
+		info.Actor = "unknown"
+	}
+	if info.Method == "" {
+		info.Method = method
+	}
+	return info
+}
+
+// audit records a change to an enemy as part of the transaction of q. before
+// is nil for added enemies, and after is nil for purged ones.
+func audit(ctx context.Context, q *Queries, method string, before, after *enemy.Enemy) error {
+	info := auditInfoFrom(ctx, method)
+	var enemyID string
+	if before != nil {
+		enemyID = before.GetId()
+	} else if after != nil {
+		enemyID = after.GetId()
+	}
+	beforeJSON, err := enemyJSON(before)
+	if err != nil {
+		return err
+	}
+	afterJSON, err := enemyJSON(after)
+	if err != nil {
+		return err
+	}
+	return q.AddAuditEvent(ctx, AddAuditEventParams{
+		EnemyID:   enemyID,
+		Actor:     info.Actor,
+		Method:    info.Method,
+		Peer:      info.Peer,
+		Before:    beforeJSON,
+		After:     afterJSON,
+		CreatedAt: now(),
+	})
+}
+
+// auditRelated records a change to data about an enemy kept apart from it, like
+// its grievances. The enemy itself doesn't change, so it is both before and
+// after, and the method tells what happened.
+func (e *EnemyStore) auditRelated(ctx context.Context, q *Queries, method, enemyID string) error {
+	enmy, err := q.GetEnemy(ctx, GetEnemyParams{EnemyID: enemyID, ShowDeleted: true})
+	if err != nil {
+		return err
+	}
+	snap, err := e.snapshot(ctx, q, enmy)
+	if err != nil {
+		return err
+	}
+	return audit(ctx, q, method, snap, snap)
+}
+
+func enemyJSON(enmy *enemy.Enemy) (json.RawMessage, error) {
+	if enmy == nil {
+		return json.RawMessage("null"), nil
+	}
+	return protojson.Marshal(enmy)
+}
+
+func enemyFromJSON(b json.RawMessage) (*enemy.Enemy, error) {
+	if string(b) == "null" {
+		return nil, nil
+	}
+	var enmy enemy.Enemy
+	if err := protojson.Unmarshal(b, &enmy); err != nil {
+		return nil, err
+	}
+	return &enmy, nil
+}
+
+// auditPageToken is the cursor for ListAuditEvents.
+type auditPageToken struct {
+	LastID int32 `json:"lastId"`
+}
+
+func (e *EnemyStore) ListAuditEvents(ctx context.Context, req *enemy.ListAuditEventsRequest) (*enemy.ListAuditEventsResponse, error) {
+	token := auditPageToken{LastID: math.MaxInt32}
+	if req.GetPageToken() != "" {
+		if err := decodeToken(req.GetPageToken(), &token); err != nil {
+			return nil, err
+		}
+	}
+	var startTime time.Time
+	if req.GetStartTime() != nil {
+		startTime = req.GetStartTime().AsTime()
+	}
+	endTime := maxTime
+	if req.GetEndTime() != nil {
+		endTime = req.GetEndTime().AsTime()
+	}
+	var rows []AuditLog
+	n, nextPageToken, err := page(req.GetPageSize(), func(limit int32) (int, error) {
+		var err error
+		rows, err = e.queries.ListAuditEvents(ctx, ListAuditEventsParams{
+			EnemyID:   req.GetEnemyId(),
+			Actor:     req.GetActor(),
+			StartTime: startTime,
+			EndTime:   endTime,
+			BeforeID:  token.LastID,
+			RowLimit:  limit,
+		})
+		return len(rows), err
+	}, func(last int) string {
+		return encodeToken(auditPageToken{LastID: rows[last].ID})
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	var res []*enemy.AuditEvent
+	for _, row := range rows[:n] {
+		before, err := enemyFromJSON(row.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := enemyFromJSON(row.After)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &enemy.AuditEvent{
+			EnemyId: row.EnemyID,
+			Actor:   row.Actor,
+			Method:  row.Method,
+			Peer:    row.Peer,
+			Before:  before,
+			After:   after,
+			Time:    timestamppb.New(row.CreatedAt),
+		})
+	}
+	return &enemy.ListAuditEventsResponse{
+		Events:        res,
+		NextPageToken: nextPageToken,
+	}, nil
+}
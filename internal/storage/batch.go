@@ -0,0 +1,202 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+)
+
+// Number of enemies added per transaction by BatchAddEnemies when the batch
+// isn't atomic.
+const batchChunkSize = 100
+
+// BatchResult is the outcome for one item of a batch. Exactly one of Enemy
+// and Err is set.
+type BatchResult struct {
+	Enemy *enemy.Enemy
+	// Set if Enemy is from an earlier request with the same idempotency key,
+	// in which case nothing was added.
+	Replayed bool
+	Err      error
+}
+
+// BatchAddEnemies adds the enemies of reqs, honouring their idempotency keys
+// like AddEnemy. If atomic, either all enemies are added or an error is
+// returned. Otherwise the enemies are added in chunks, and the result for each
+// enemy tells whether it was added.
+func (e *EnemyStore) BatchAddEnemies(ctx context.Context, reqs []*enemy.AddEnemyRequest, atomic bool) ([]BatchResult, error) {
+	results := make([]BatchResult, len(reqs))
+	if atomic {
+		err := e.inTx(ctx, func(q *Queries) error {
+			for i, req := range reqs {
+				res, replayed, err := e.batchAddEnemy(ctx, q, req)
+				if err != nil {
+					return batchError(i, err)
+				}
+				results[i] = BatchResult{Enemy: res.GetEnemy(), Replayed: replayed}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, dbError(err)
+		}
+		return results, nil
+	}
+
+	for start := 0; start < len(reqs); start += batchChunkSize {
+		end := start + batchChunkSize
+		if end > len(reqs) {
+			end = len(reqs)
+		}
+		err := e.inTx(ctx, func(q *Queries) error {
+			for i := start; i < end; i++ {
+				// A failed statement aborts the transaction, so roll back to
+				// before the failing enemy and carry on with the rest.
+				if err := q.exec(ctx, "SAVEPOINT batch_item"); err != nil {
+					return err
+				}
+				res, replayed, err := e.batchAddEnemy(ctx, q, reqs[i])
+				if err != nil {
+					results[i] = BatchResult{Err: dbError(err)}
+					if err := q.exec(ctx, "ROLLBACK TO SAVEPOINT batch_item"); err != nil {
+						return err
+					}
+					continue
+				}
+				results[i] = BatchResult{Enemy: res.GetEnemy(), Replayed: replayed}
+				if err := q.exec(ctx, "RELEASE SAVEPOINT batch_item"); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			// Nothing in the chunk was added.
+			for i := start; i < end; i++ {
+				results[i] = BatchResult{Err: dbError(err)}
+			}
+		}
+	}
+	return results, nil
+}
+
+// DryRunAddEnemies adds the enemies returned by next, until it returns io.EOF,
+// in a single transaction that is rolled back. It returns the rows that adding
+// them one by one, like the chunks of BatchAddEnemies do, would reject, without
+// adding any. Rows are numbered from 1 in the order next returns them.
+func (e *EnemyStore) DryRunAddEnemies(ctx context.Context, next func() (*enemy.AddEnemyRequest, error)) ([]*enemy.RejectedRow, error) {
+	var rejected []*enemy.RejectedRow
+	err := e.inRolledBackTx(ctx, func(q *Queries) error {
+		for line := int32(1); ; line++ {
+			req, err := next()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			if err := q.exec(ctx, "SAVEPOINT batch_item"); err != nil {
+				return err
+			}
+			if _, _, err := e.batchAddEnemy(ctx, q, req); err != nil {
+				var storageErr *Error
+				if !errors.As(dbError(err), &storageErr) {
+					return err
+				}
+				rejected = append(rejected, &enemy.RejectedRow{Line: line, Reason: storageErr.Msg})
+				if err := q.exec(ctx, "ROLLBACK TO SAVEPOINT batch_item"); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := q.exec(ctx, "RELEASE SAVEPOINT batch_item"); err != nil {
+				return err
+			}
+		}
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return rejected, nil
+}
+
+// batchAddEnemy adds one enemy of a batch, and reports whether its response
+// was replayed.
+func (e *EnemyStore) batchAddEnemy(ctx context.Context, q *Queries, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error) {
+	if req.GetIdempotencyKey() != "" {
+		return e.addEnemyWithKey(ctx, q, req)
+	}
+	res, err := e.addEnemy(ctx, q, req)
+	return res, false, err
+}
+
+func (q *Queries) exec(ctx context.Context, query string) error {
+	_, err := q.db.ExecContext(ctx, query)
+	return err
+}
+
+// batchError tells which item of a batch err is about.
+func batchError(i int, err error) error {
+	err = dbError(err)
+	var storageErr *Error
+	if !errors.As(err, &storageErr) {
+		return err
+	}
+	return &Error{
+		Kind: storageErr.Kind,
+		Msg:  fmt.Sprintf("enemy %d: %s", i, storageErr.Msg),
+		ID:   storageErr.ID,
+	}
+}
+
+// BatchGetEnemies returns the enemies in the order their ids are asked for,
+// along with the ids that weren't found.
+func (e *EnemyStore) BatchGetEnemies(ctx context.Context, req *enemy.BatchGetEnemiesRequest) (*enemy.BatchGetEnemiesResponse, error) {
+	enmys, err := e.queries.BatchGetEnemies(ctx, BatchGetEnemiesParams{
+		EnemyIds:    textArray(req.GetIds()),
+		ShowDeleted: req.GetShowDeleted(),
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	byID := make(map[string]Enemy, len(enmys))
+	for _, enmy := range enmys {
+		byID[enmy.EnemyID] = enmy
+	}
+	res := &enemy.BatchGetEnemiesResponse{}
+	for _, enemyID := range req.GetIds() {
+		enmy, ok := byID[enemyID]
+		if !ok {
+			res.MissingIds = append(res.MissingIds, enemyID)
+			continue
+		}
+		res.Enemies = append(res.Enemies, toProto(enmy))
+	}
+	if err := e.loadDetails(ctx, e.queries, voter(ctx), res.Enemies...); err != nil {
+		return nil, dbError(err)
+	}
+	return res, nil
+}
+
+// BatchUpdateEnemies makes all the updates in one transaction. If any of them
+// fails, none are made.
+func (e *EnemyStore) BatchUpdateEnemies(ctx context.Context, req *enemy.BatchUpdateEnemiesRequest) (*enemy.BatchUpdateEnemiesResponse, error) {
+	res := &enemy.BatchUpdateEnemiesResponse{}
+	err := e.inTx(ctx, func(q *Queries) error {
+		for i, updateReq := range req.GetRequests() {
+			enmy, err := e.updateEnemy(ctx, q, updateReq)
+			if err != nil {
+				return batchError(i, err)
+			}
+			res.Enemies = append(res.Enemies, enmy)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return res, nil
+}
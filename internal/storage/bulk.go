@@ -0,0 +1,210 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/stdlib"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/rs/xid"
+)
+
+// BulkLoadEnemies upserts the rows returned by next, until it returns io.EOF.
+// The rows are copied into a staging table using the COPY protocol, and then
+// upserted into enemies in a single transaction, which is much faster than
+// adding them one by one. Invalid rows are rejected and don't stop the load.
+// The events for the added and updated enemies are returned along with the
+// response.
+func (e *EnemyStore) BulkLoadEnemies(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, []*enemy.EnemyEvent, error) {
+	return e.bulkLoad(ctx, next, e.inTx)
+}
+
+// DryRunBulkLoadEnemies is like BulkLoadEnemies, but the upsert is rolled
+// back. It tells which rows would be rejected, and how many enemies would be
+// inserted and updated, without changing any.
+func (e *EnemyStore) DryRunBulkLoadEnemies(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error)) (*enemy.BulkLoadEnemiesResponse, error) {
+	res, _, err := e.bulkLoad(ctx, next, e.inRolledBackTx)
+	return res, err
+}
+
+// bulkLoad does a bulk load, upserting the staged rows in a transaction run by
+// inTx.
+func (e *EnemyStore) bulkLoad(ctx context.Context, next func() (*enemy.BulkLoadEnemiesRequest, error), inTx func(ctx context.Context, fn func(q *Queries) error) error) (*enemy.BulkLoadEnemiesResponse, []*enemy.EnemyEvent, error) {
+	src := &bulkSource{
+		next:   next,
+		loadID: xid.New().String(),
+		lines:  map[string]int32{},
+	}
+	// Staged rows are only needed during the load, whether it succeeds or not.
+	defer func() {
+		if err := e.queries.DeleteStagedEnemies(context.Background(), src.loadID); err != nil {
+			log.Printf("deleting staged enemies of load %s failed: %v", src.loadID, err)
+		}
+	}()
+	if err := e.copyToStaging(src); err != nil {
+		return nil, nil, err
+	}
+
+	res := &enemy.BulkLoadEnemiesResponse{}
+	var events []*enemy.EnemyEvent
+	err := inTx(ctx, func(q *Queries) error {
+		existing, err := q.GetStagedEnemiesForUpdate(ctx, src.loadID)
+		if err != nil {
+			return err
+		}
+		before := make(map[string]Enemy, len(existing))
+		for _, enmy := range existing {
+			before[strings.ToLower(enmy.Email)] = enmy
+		}
+		upserted, err := q.UpsertStagedEnemies(ctx, UpsertStagedEnemiesParams{
+			LastUpdated: now(),
+			LoadID:      src.loadID,
+		})
+		if err != nil {
+			return err
+		}
+		// Bulk loads don't change tags or votes, so the snapshots before and
+		// after share them.
+		afters := make([]*enemy.Enemy, len(upserted))
+		for i, enmy := range upserted {
+			afters[i] = toProto(enmy)
+		}
+		if err := e.loadDetails(ctx, q, "", afters...); err != nil {
+			return err
+		}
+		for i := range upserted {
+			enmy, after := &upserted[i], afters[i]
+			old, updated := before[strings.ToLower(enmy.Email)]
+			if err := q.AddEnemyVersion(ctx, AddEnemyVersionParams{ID: enmy.ID, ValidFrom: enmy.LastUpdated}); err != nil {
+				return err
+			}
+			if !updated || old.Rating != enmy.Rating {
+				if err := q.AddRatingHistory(ctx, AddRatingHistoryParams{
+					EnemyID:   enmy.ID,
+					Rating:    enmy.Rating,
+					ChangedAt: enmy.LastUpdated,
+				}); err != nil {
+					return err
+				}
+			}
+			if updated {
+				res.Updated++
+				oldProto := toProto(old)
+				oldProto.Tags = after.GetTags()
+				if err := audit(ctx, q, "BulkLoadEnemies", oldProto, after); err != nil {
+					return err
+				}
+				if err := notify(ctx, q, enemy.EnemyEvent_UPDATED, after); err != nil {
+					return err
+				}
+				events = append(events, &enemy.EnemyEvent{Type: enemy.EnemyEvent_UPDATED, Enemy: after})
+				continue
+			}
+			res.Inserted++
+			if err := audit(ctx, q, "BulkLoadEnemies", nil, after); err != nil {
+				return err
+			}
+			if err := notify(ctx, q, enemy.EnemyEvent_ADDED, after); err != nil {
+				return err
+			}
+			events = append(events, &enemy.EnemyEvent{Type: enemy.EnemyEvent_ADDED, Enemy: after})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, dbError(err)
+	}
+	sort.Slice(src.rejected, func(i, j int) bool {
+		return src.rejected[i].GetLine() < src.rejected[j].GetLine()
+	})
+	res.Rejected = int32(len(src.rejected))
+	res.RejectedRows = src.rejected
+	return res, events, nil
+}
+
+func (e *EnemyStore) copyToStaging(src *bulkSource) error {
+	conn, err := stdlib.AcquireConn(e.db)
+	if err != nil {
+		return dbError(err)
+	}
+	defer stdlib.ReleaseConn(e.db, conn)
+	_, err = conn.CopyFrom(
+		pgx.Identifier{"enemy_staging"},
+		[]string{"load_id", "line", "enemy_id", "full_name", "email", "rating"},
+		src,
+	)
+	if src.err != nil {
+		// Most likely the client went away, which isn't a database error.
+		return src.err
+	}
+	return dbError(err)
+}
+
+// bulkSource feeds the rows of a bulk load to COPY, rejecting invalid rows on
+// the way.
+type bulkSource struct {
+	next   func() (*enemy.BulkLoadEnemiesRequest, error)
+	loadID string
+	line   int32
+	row    []interface{}
+	err    error
+	// Line of the last row seen with each email, lowercased.
+	lines    map[string]int32
+	rejected []*enemy.RejectedRow
+}
+
+func (s *bulkSource) Next() bool {
+	for {
+		req, err := s.next()
+		if err == io.EOF {
+			return false
+		}
+		if err != nil {
+			s.err = err
+			return false
+		}
+		s.line++
+		if reason := validateBulkRow(req); reason != "" {
+			s.rejected = append(s.rejected, &enemy.RejectedRow{Line: s.line, Reason: reason})
+			continue
+		}
+		email := strings.ToLower(req.GetEmail())
+		if prev, ok := s.lines[email]; ok {
+			s.rejected = append(s.rejected, &enemy.RejectedRow{
+				Line:   prev,
+				Reason: fmt.Sprintf("email %q is used again on line %d", req.GetEmail(), s.line),
+			})
+		}
+		s.lines[email] = s.line
+		s.row = []interface{}{s.loadID, s.line, id(), req.GetName(), req.GetEmail(), req.GetRating()}
+		return true
+	}
+}
+
+func (s *bulkSource) Values() ([]interface{}, error) {
+	return s.row, nil
+}
+
+func (s *bulkSource) Err() error {
+	return s.err
+}
+
+// validateBulkRow applies the same rules as AddEnemy, returning why row is
+// invalid, if it is.
+func validateBulkRow(row *enemy.BulkLoadEnemiesRequest) string {
+	switch {
+	case row.GetName() == "":
+		return "enemy name can't be empty"
+	case row.GetEmail() == "":
+		return "enemy email can't be empty"
+	}
+	if err := ValidateRating(row.GetRating()); err != nil {
+		return err.Error()
+	}
+	return ""
+}
@@ -0,0 +1,126 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Postgres channel changes to enemies are announced on.
+const changesChannel = "enemy_changes"
+
+// Time to wait before reconnecting after losing the database connection,
+// doubled for every failed attempt up to the max.
+const (
+	minReconnectBackoff = time.Second
+	maxReconnectBackoff = time.Minute
+)
+
+// notify announces a change to every ChangeListener. Like the rest of the
+// transaction of q, it only takes effect on commit. Notification payloads have
+// to be shorter than 8000 bytes, so only the id and etag of the enemy are sent,
+// and listeners load the rest.
+func notify(ctx context.Context, q *Queries, typ enemy.EnemyEvent_Type, enmy *enemy.Enemy) error {
+	payload, err := protojson.Marshal(&enemy.EnemyEvent{
+		Type:  typ,
+		Enemy: &enemy.Enemy{Id: enmy.GetId(), Etag: enmy.GetEtag()},
+		Time:  timestamppb.New(now()),
+	})
+	if err != nil {
+		return err
+	}
+	return q.NotifyChange(ctx, NotifyChangeParams{Channel: changesChannel, Payload: string(payload)})
+}
+
+// changedEnemy loads the version of enmy a notification is about. Purged
+// enemies have no versions left, so only their id and etag are known.
+func (e *EnemyStore) changedEnemy(ctx context.Context, enmy *enemy.Enemy) (*enemy.Enemy, error) {
+	version, err := parseEtag(enmy.GetEtag())
+	if err != nil {
+		return nil, err
+	}
+	row, err := e.queries.GetEnemyVersion(ctx, GetEnemyVersionParams{EnemyID: enmy.GetId(), Version: version})
+	if errors.Is(err, sql.ErrNoRows) {
+		return enmy, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e.snapshot(ctx, e.queries, Enemy(row))
+}
+
+// ChangeListener receives the changes made by the EnemyStores of every replica
+// using the database.
+type ChangeListener struct {
+	config pgx.ConnConfig
+	// Loads the enemies notifications are about.
+	store *EnemyStore
+}
+
+func NewChangeListener(config pgx.ConnConfig, store *EnemyStore) *ChangeListener {
+	return &ChangeListener{config: config, store: store}
+}
+
+// Listen sends changes to ch until ctx is done, reconnecting to the database
+// whenever the connection is lost. Changes made while disconnected are missed.
+func (l *ChangeListener) Listen(ctx context.Context, ch chan<- *enemy.EnemyEvent) error {
+	backoff := minReconnectBackoff
+	for {
+		err := l.listen(ctx, ch, func() { backoff = minReconnectBackoff })
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Printf("listening for changes failed: %v. Reconnecting in %s", err, backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxReconnectBackoff {
+			backoff = maxReconnectBackoff
+		}
+	}
+}
+
+// listen sends changes to ch until the connection fails. connected is called
+// once it is listening.
+func (l *ChangeListener) listen(ctx context.Context, ch chan<- *enemy.EnemyEvent, connected func()) error {
+	conn, err := pgx.Connect(l.config)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.Listen(changesChannel); err != nil {
+		return err
+	}
+	connected()
+	for {
+		n, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return err
+		}
+		var ev enemy.EnemyEvent
+		if err := protojson.Unmarshal([]byte(n.Payload), &ev); err != nil {
+			log.Printf("ignoring malformed change notification: %v", err)
+			continue
+		}
+		// Watchers still learn that the enemy changed if it can't be loaded.
+		if enmy, err := l.store.changedEnemy(ctx, ev.GetEnemy()); err != nil {
+			log.Printf("unable to load changed enemy %s: %v", ev.GetEnemy().GetId(), err)
+		} else {
+			ev.Enemy = enmy
+		}
+		select {
+		case ch <- &ev:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
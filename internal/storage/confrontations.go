@@ -0,0 +1,227 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"math"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Elo parameters, scaled to ratings that mostly lie between 0 and 10.
+const (
+	defaultKFactor = 0.5
+	// Our own rating, which enemies are rated against.
+	ourRating = 5.0
+	// An enemy rated this much higher than us is expected to win ten times
+	// as often as we do.
+	eloScale = 4.0
+	// Ratings have to stay positive.
+	minRating = 0.1
+)
+
+// RecordConfrontation updates the rating of the enemy from its current value,
+// unless the confrontation is backdated before others of the enemy. Then every
+// confrontation of the enemy is replayed from its base rating, like
+// RecomputeRatings does.
+func (e *EnemyStore) RecordConfrontation(ctx context.Context, req *enemy.RecordConfrontationRequest) (*enemy.RecordConfrontationResponse, error) {
+	createdAt := now()
+	occurredAt := createdAt
+	if req.GetOccurredAt() != nil {
+		occurredAt = req.GetOccurredAt().AsTime()
+	}
+	res := &enemy.RecordConfrontationResponse{}
+	err := e.inTx(ctx, func(q *Queries) error {
+		current, err := q.GetEnemyForUpdate(ctx, req.GetEnemyId())
+		if err == nil && current.DeletedAt.Valid {
+			err = sql.ErrNoRows
+		}
+		if err != nil {
+			return err
+		}
+		if err := q.SetRatingBase(ctx, SetRatingBaseParams{EnemyID: current.ID, Rating: current.Rating}); err != nil {
+			return err
+		}
+		backdated, err := q.HasConfrontationsAfter(ctx, HasConfrontationsAfterParams{EnemyID: current.ID, OccurredAt: occurredAt})
+		if err != nil {
+			return err
+		}
+		confrontation, err := q.AddConfrontation(ctx, AddConfrontationParams{
+			ConfrontationID: id(),
+			EnemyID:         current.ID,
+			Outcome:         int32(req.GetOutcome()),
+			OccurredAt:      occurredAt,
+			Notes:           req.GetNotes(),
+			RatingBefore:    current.Rating,
+			RatingAfter:     e.elo(current.Rating, req.GetOutcome()),
+			CreatedAt:       createdAt,
+		})
+		if err != nil {
+			return err
+		}
+		rating := confrontation.RatingAfter
+		if backdated {
+			confrontations, err := q.ListConfrontationsForUpdate(ctx, ListConfrontationsForUpdateParams{EnemyID: current.ID})
+			if err != nil {
+				return err
+			}
+			if rating, err = e.replay(ctx, q, confrontations); err != nil {
+				return err
+			}
+			for _, c := range confrontations {
+				if c.ID == confrontation.ID {
+					confrontation.RatingBefore, confrontation.RatingAfter = c.RatingBefore, c.RatingAfter
+				}
+			}
+		}
+		res.Confrontation = confrontationToProto(confrontation, current.EnemyID)
+		res.Enemy, err = e.setRating(ctx, q, "RecordConfrontation", current, rating)
+		return err
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return res, nil
+}
+
+// RecomputeRatings replays every confrontation in one transaction, and
+// returns the enemies whose rating changed along with the response. Deleted
+// enemies get their confrontations replayed, but keep their rating.
+func (e *EnemyStore) RecomputeRatings(ctx context.Context, req *enemy.RecomputeRatingsRequest) (*enemy.RecomputeRatingsResponse, []*enemy.Enemy, error) {
+	res := &enemy.RecomputeRatingsResponse{}
+	var updated []*enemy.Enemy
+	err := e.inTx(ctx, func(q *Queries) error {
+		confrontations, err := q.ListConfrontationsForUpdate(ctx, ListConfrontationsForUpdateParams{AllEnemies: true})
+		if err != nil {
+			return err
+		}
+		res.Confrontations = int32(len(confrontations))
+		// Confrontations are ordered by enemy, so each enemy is a run.
+		for start := 0; start < len(confrontations); {
+			enemyID := confrontations[start].EnemyID
+			end := start
+			for end < len(confrontations) && confrontations[end].EnemyID == enemyID {
+				end++
+			}
+			rating, err := e.replay(ctx, q, confrontations[start:end])
+			if err != nil {
+				return err
+			}
+			start = end
+
+			current, err := q.GetEnemyByIDForUpdate(ctx, enemyID)
+			if err != nil {
+				return err
+			}
+			if current.DeletedAt.Valid || current.Rating == rating {
+				continue
+			}
+			enmy, err := e.setRating(ctx, q, "RecomputeRatings", current, rating)
+			if err != nil {
+				return err
+			}
+			updated = append(updated, enmy)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, dbError(err)
+	}
+	res.EnemiesUpdated = int32(len(updated))
+	return res, updated, nil
+}
+
+// replay recomputes the ratings of the confrontations of one enemy, in the
+// order they are listed in, starting from the base rating of the enemy.
+// Ratings that changed are saved and updated in confrontations. It returns the
+// rating after the last confrontation.
+func (e *EnemyStore) replay(ctx context.Context, q *Queries, confrontations []ListConfrontationsForUpdateRow) (float32, error) {
+	rating := confrontations[0].BaseRating
+	for i := range confrontations {
+		c := &confrontations[i]
+		after := e.elo(rating, enemy.Confrontation_Outcome(c.Outcome))
+		if c.RatingBefore != rating || c.RatingAfter != after {
+			if err := q.SetConfrontationRatings(ctx, SetConfrontationRatingsParams{
+				RatingBefore: rating,
+				RatingAfter:  after,
+				ID:           c.ID,
+			}); err != nil {
+				return 0, err
+			}
+			c.RatingBefore, c.RatingAfter = rating, after
+		}
+		rating = after
+	}
+	return rating, nil
+}
+
+// elo returns the rating of an enemy after a confrontation. The enemy scores
+// 1 when we lose, and 0 when we win. The result only depends on the
+// arguments and the K-factor, so replaying confrontations is deterministic.
+func (e *EnemyStore) elo(rating float32, outcome enemy.Confrontation_Outcome) float32 {
+	var score float64
+	switch outcome {
+	case enemy.Confrontation_LOSS:
+		score = 1
+	case enemy.Confrontation_DRAW:
+		score = 0.5
+	}
+	expected := 1 / (1 + math.Pow(10, (ourRating-float64(rating))/eloScale))
+	next := float64(rating) + e.kFactor*(score-expected)
+	if next < minRating {
+		next = minRating
+	}
+	return float32(next)
+}
+
+// setRating updates the rating of current, which must be locked by the
+// transaction of q.
+func (e *EnemyStore) setRating(ctx context.Context, q *Queries, method string, current Enemy, rating float32) (*enemy.Enemy, error) {
+	before, err := e.snapshot(ctx, q, current)
+	if err != nil {
+		return nil, err
+	}
+	enmy, err := q.UpdateEnemy(ctx, UpdateEnemyParams{
+		SetRating:   true,
+		Rating:      rating,
+		LastUpdated: now(),
+		EnemyID:     current.EnemyID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := q.AddEnemyVersion(ctx, AddEnemyVersionParams{ID: enmy.ID, ValidFrom: enmy.LastUpdated}); err != nil {
+		return nil, err
+	}
+	if err := q.AddRatingHistory(ctx, AddRatingHistoryParams{
+		EnemyID:   enmy.ID,
+		Rating:    enmy.Rating,
+		ChangedAt: enmy.LastUpdated,
+	}); err != nil {
+		return nil, err
+	}
+	after, err := e.snapshot(ctx, q, enmy)
+	if err != nil {
+		return nil, err
+	}
+	if err := audit(ctx, q, method, before, after); err != nil {
+		return nil, err
+	}
+	if err := notify(ctx, q, enemy.EnemyEvent_UPDATED, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+func confrontationToProto(c Confrontation, enemyID string) *enemy.Confrontation {
+	return &enemy.Confrontation{
+		Id:           c.ConfrontationID,
+		EnemyId:      enemyID,
+		Outcome:      enemy.Confrontation_Outcome(c.Outcome),
+		OccurredAt:   timestamppb.New(c.OccurredAt),
+		Notes:        c.Notes,
+		RatingBefore: c.RatingBefore,
+		RatingAfter:  c.RatingAfter,
+	}
+}
@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"testing"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElo(t *testing.T) {
+	tests := []struct {
+		name    string
+		kFactor float64
+		rating  float32
+		outcome enemy.Confrontation_Outcome
+		want    float32
+	}{
+		{
+			name:    "Test loss against equal",
+			kFactor: 0.5,
+			rating:  5,
+			outcome: enemy.Confrontation_LOSS,
+			want:    5.25,
+		},
+		{
+			name:    "Test win against equal",
+			kFactor: 0.5,
+			rating:  5,
+			outcome: enemy.Confrontation_WIN,
+			want:    4.75,
+		},
+		{
+			name:    "Test draw against equal",
+			kFactor: 0.5,
+			rating:  5,
+			outcome: enemy.Confrontation_DRAW,
+			want:    5,
+		},
+		{
+			name:    "Test win against stronger",
+			kFactor: 1,
+			rating:  9,
+			outcome: enemy.Confrontation_WIN,
+			// Expected to win 10 of 11 times.
+			want: 9 - float32(10.0/11.0),
+		},
+		{
+			name:    "Test rating stays positive",
+			kFactor: 10,
+			rating:  1,
+			outcome: enemy.Confrontation_WIN,
+			want:    minRating,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := &EnemyStore{kFactor: test.kFactor}
+			assert.InDelta(t, test.want, e.elo(test.rating, test.outcome), 0.0001)
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/jackc/pgx"
+)
+
+// Errors returned by EnemyStore wrap one of these, so callers can use
+// errors.Is to tell what went wrong.
+var (
+	ErrInvalidArgument = errors.New("invalid argument")
+	ErrNotFound        = errors.New("not found")
+	ErrConflict        = errors.New("conflict")
+	ErrPrecondition    = errors.New("failed precondition")
+	ErrAborted         = errors.New("aborted")
+	ErrUnavailable     = errors.New("unavailable")
+	ErrTimeout         = errors.New("timeout")
+)
+
+// Error pairs one of the sentinel errors with a message that is safe to show
+// to clients.
+type Error struct {
+	Kind error
+	Msg  string
+	// Id of the enemy the error is about, if any. Eg. the enemy already using
+	// an email for ErrConflict.
+	ID string
+}
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+func errorf(kind error, format string, a ...interface{}) error {
+	return &Error{Kind: kind, Msg: fmt.Sprintf(format, a...)}
+}
+
+// Postgres error codes, see
+// https://www.postgresql.org/docs/current/errcodes-appendix.html.
+const (
+	pgUniqueViolation      = "23505"
+	pgSerializationFailure = "40001"
+	pgDeadlockDetected     = "40P01"
+	pgQueryCanceled        = "57014"
+	pgAdminShutdown        = "57P01"
+	pgCrashShutdown        = "57P02"
+	pgCannotConnectNow     = "57P03"
+	pgConnectionException  = "08"
+)
+
+// Unique index on email, see schema/0002_unique_enemies.sql.
+const emailConstraint = "enemies_email_key"
+
+// dbError translates errors from the database into the storage error model.
+// Errors it doesn't recognize are returned as is.
+func dbError(err error) error {
+	var pgErr pgx.PgError
+	var netErr net.Error
+	switch {
+	case err == nil:
+		return nil
+	case errors.As(err, new(*Error)):
+		return err
+	case errors.Is(err, sql.ErrNoRows):
+		return errorf(ErrNotFound, "enemy not found")
+	case errors.Is(err, context.DeadlineExceeded):
+		return errorf(ErrTimeout, "request timed out")
+	case errors.Is(err, driver.ErrBadConn), errors.Is(err, pgx.ErrDeadConn), errors.As(err, &netErr):
+		return errorf(ErrUnavailable, "database unavailable")
+	case errors.As(err, &pgErr):
+		switch {
+		case pgErr.Code == pgUniqueViolation:
+			return errorf(ErrConflict, "enemy already exists")
+		case pgErr.Code == pgSerializationFailure, pgErr.Code == pgDeadlockDetected:
+			return errorf(ErrAborted, "transaction aborted due to concurrent update, retry")
+		case pgErr.Code == pgQueryCanceled:
+			return errorf(ErrTimeout, "query timed out")
+		case pgErr.Code == pgAdminShutdown, pgErr.Code == pgCrashShutdown, pgErr.Code == pgCannotConnectNow,
+			strings.HasPrefix(pgErr.Code, pgConnectionException):
+			return errorf(ErrUnavailable, "database unavailable")
+		}
+	}
+	return err
+}
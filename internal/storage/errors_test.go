@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDbError(t *testing.T) {
+	tests := []struct {
+		name string
+		give error
+		want error
+	}{
+		{
+			name: "Test nil",
+		},
+		{
+			name: "Test no rows",
+			give: fmt.Errorf("scanning: %w", sql.ErrNoRows),
+			want: errorf(ErrNotFound, "enemy not found"),
+		},
+		{
+			name: "Test unique violation",
+			give: pgx.PgError{Code: "23505", Message: "duplicate key value violates unique constraint"},
+			want: errorf(ErrConflict, "enemy already exists"),
+		},
+		{
+			name: "Test statement timeout",
+			give: pgx.PgError{Code: "57014", Message: "canceling statement due to statement timeout"},
+			want: errorf(ErrTimeout, "query timed out"),
+		},
+		{
+			name: "Test connection failure",
+			give: pgx.PgError{Code: "08006", Message: "connection failure"},
+			want: errorf(ErrUnavailable, "database unavailable"),
+		},
+		{
+			name: "Test bad connection",
+			give: driver.ErrBadConn,
+			want: errorf(ErrUnavailable, "database unavailable"),
+		},
+		{
+			name: "Test context deadline",
+			give: context.DeadlineExceeded,
+			want: errorf(ErrTimeout, "request timed out"),
+		},
+		{
+			name: "Test already translated",
+			give: errorf(ErrInvalidArgument, "invalid page token"),
+			want: errorf(ErrInvalidArgument, "invalid page token"),
+		},
+		{
+			name: "Test unknown",
+			give: errors.New("some error"),
+			want: errors.New("some error"),
+		},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.want, dbError(test.give), test.name)
+	}
+}
@@ -0,0 +1,140 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// AddGrievance adds a grievance against an enemy that isn't deleted. It is
+// created by the actor of the audit info of ctx.
+func (e *EnemyStore) AddGrievance(ctx context.Context, req *enemy.AddGrievanceRequest) (*enemy.AddGrievanceResponse, error) {
+	createdAt := now()
+	occurredAt := createdAt
+	if req.GetOccurredAt() != nil {
+		occurredAt = req.GetOccurredAt().AsTime()
+	}
+	var grievance Grievance
+	err := e.inTx(ctx, func(q *Queries) error {
+		var err error
+		grievance, err = q.AddGrievance(ctx, AddGrievanceParams{
+			GrievanceID: id(),
+			Text:        req.GetText(),
+			Severity:    int32(req.GetSeverity()),
+			OccurredAt:  occurredAt,
+			CreatedBy:   auditInfoFrom(ctx, "AddGrievance").Actor,
+			CreatedAt:   createdAt,
+			EnemyID:     req.GetEnemyId(),
+		})
+		if err != nil {
+			return err
+		}
+		return e.auditRelated(ctx, q, "AddGrievance", req.GetEnemyId())
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &enemy.AddGrievanceResponse{
+		Grievance: grievanceToProto(grievance, req.GetEnemyId()),
+	}, nil
+}
+
+func (e *EnemyStore) ListGrievances(ctx context.Context, req *enemy.ListGrievancesRequest) (*enemy.ListGrievancesResponse, error) {
+	token := grievancePageToken{OccurredAt: maxTime, LastID: math.MaxInt32}
+	if req.GetPageToken() != "" {
+		if err := decodeToken(req.GetPageToken(), &token); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := e.queries.GetEnemy(ctx, GetEnemyParams{EnemyID: req.GetEnemyId(), ShowDeleted: true}); err != nil {
+		return nil, dbError(err)
+	}
+	var grievances []Grievance
+	n, nextPageToken, err := page(req.GetPageSize(), func(limit int32) (int, error) {
+		var err error
+		grievances, err = e.queries.ListGrievances(ctx, ListGrievancesParams{
+			EnemyID:          req.GetEnemyId(),
+			BeforeOccurredAt: token.OccurredAt,
+			BeforeID:         token.LastID,
+			RowLimit:         limit,
+		})
+		return len(grievances), err
+	}, func(last int) string {
+		return encodeToken(grievancePageToken{OccurredAt: grievances[last].OccurredAt, LastID: grievances[last].ID})
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	var res []*enemy.Grievance
+	for _, grievance := range grievances[:n] {
+		res = append(res, grievanceToProto(grievance, req.GetEnemyId()))
+	}
+	return &enemy.ListGrievancesResponse{
+		Grievances:    res,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (e *EnemyStore) DeleteGrievance(ctx context.Context, req *enemy.DeleteGrievanceRequest) (*enemy.DeleteGrievanceResponse, error) {
+	var grievance Grievance
+	err := e.inTx(ctx, func(q *Queries) error {
+		var err error
+		grievance, err = q.DeleteGrievance(ctx, DeleteGrievanceParams{
+			EnemyID:     req.GetEnemyId(),
+			GrievanceID: req.GetId(),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorf(ErrNotFound, "grievance %s of enemy %s not found", req.GetId(), req.GetEnemyId())
+		}
+		if err != nil {
+			return err
+		}
+		return e.auditRelated(ctx, q, "DeleteGrievance", req.GetEnemyId())
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &enemy.DeleteGrievanceResponse{
+		Grievance: grievanceToProto(grievance, req.GetEnemyId()),
+	}, nil
+}
+
+// grievanceSummary adds the number of grievances against the enemy of res,
+// and the most recent one, to res.
+func (e *EnemyStore) grievanceSummary(ctx context.Context, res *enemy.GetEnemyResponse) error {
+	enemyID := res.GetEnemy().GetId()
+	count, err := e.queries.CountGrievances(ctx, enemyID)
+	if err != nil {
+		return err
+	}
+	latest, err := e.queries.ListGrievances(ctx, ListGrievancesParams{
+		EnemyID:          enemyID,
+		BeforeOccurredAt: maxTime,
+		BeforeID:         math.MaxInt32,
+		RowLimit:         1,
+	})
+	if err != nil {
+		return err
+	}
+	res.GrievanceCount = count
+	if len(latest) > 0 {
+		res.LatestGrievance = grievanceToProto(latest[0], enemyID)
+	}
+	return nil
+}
+
+func grievanceToProto(grievance Grievance, enemyID string) *enemy.Grievance {
+	return &enemy.Grievance{
+		Id:         grievance.GrievanceID,
+		EnemyId:    enemyID,
+		Text:       grievance.Text,
+		Severity:   enemy.Grievance_Severity(grievance.Severity),
+		OccurredAt: timestamppb.New(grievance.OccurredAt),
+		CreatedBy:  grievance.CreatedBy,
+		CreatedAt:  timestamppb.New(grievance.CreatedAt),
+	}
+}
@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/protobuf/proto"
+)
+
+// How long a response is remembered for an idempotency key.
+const idempotencyKeyTTL = 24 * time.Hour
+
+// addEnemyIdempotent adds an enemy unless the idempotency key of the request
+// has been used before, in which case the original response is replayed.
+func (e *EnemyStore) addEnemyIdempotent(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error) {
+	var res *enemy.AddEnemyResponse
+	var replayed bool
+	err := e.inTx(ctx, func(q *Queries) error {
+		var err error
+		res, replayed, err = e.addEnemyWithKey(ctx, q, req)
+		return err
+	})
+	if err != nil {
+		return nil, false, dbError(err)
+	}
+	return res, replayed, nil
+}
+
+// addEnemyWithKey is addEnemyIdempotent using the transaction of q.
+//
+// The key is claimed before the enemy is added. A concurrent request with the
+// same key blocks on the claim until the first transaction is done, and then
+// sees its response.
+func (e *EnemyStore) addEnemyWithKey(ctx context.Context, q *Queries, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error) {
+	hash, err := requestHash(req)
+	if err != nil {
+		return nil, false, err
+	}
+	t := now()
+	if err := q.DeleteExpiredIdempotencyKeys(ctx, t); err != nil {
+		return nil, false, err
+	}
+	n, err := q.ClaimIdempotencyKey(ctx, ClaimIdempotencyKeyParams{
+		IdempotencyKey: req.GetIdempotencyKey(),
+		RequestHash:    hash,
+		CreatedAt:      t,
+		ExpiresAt:      t.Add(idempotencyKeyTTL),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	if n == 0 {
+		key, err := q.GetIdempotencyKey(ctx, req.GetIdempotencyKey())
+		if err != nil {
+			return nil, false, err
+		}
+		if key.RequestHash != hash {
+			return nil, false, errorf(ErrPrecondition, "idempotency key %q was used for a different request", req.GetIdempotencyKey())
+		}
+		res := &enemy.AddEnemyResponse{}
+		if err := proto.Unmarshal(key.Response, res); err != nil {
+			return nil, false, err
+		}
+		return res, true, nil
+	}
+
+	res, err := e.addEnemy(ctx, q, req)
+	if err != nil {
+		return nil, false, err
+	}
+	b, err := proto.Marshal(res)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := q.SetIdempotencyKeyResponse(ctx, SetIdempotencyKeyResponseParams{
+		IdempotencyKey: req.GetIdempotencyKey(),
+		Response:       b,
+	}); err != nil {
+		return nil, false, err
+	}
+	return res, false, nil
+}
+
+// requestHash identifies the payload of a request, leaving out the
+// idempotency key itself.
+func requestHash(req *enemy.AddEnemyRequest) (string, error) {
+	payload := proto.Clone(req).(*enemy.AddEnemyRequest)
+	payload.IdempotencyKey = ""
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
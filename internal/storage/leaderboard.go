@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+)
+
+// Number of enemies returned by GetLeaderboard when no limit is asked for.
+const defaultLeaderboardSize = 10
+
+func (e *EnemyStore) GetLeaderboard(ctx context.Context, req *enemy.GetLeaderboardRequest) (*enemy.GetLeaderboardResponse, error) {
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultLeaderboardSize
+	}
+	var startTime time.Time
+	if req.GetStartTime() != nil {
+		startTime = req.GetStartTime().AsTime()
+	}
+	endTime := maxTime
+	if req.GetEndTime() != nil {
+		endTime = req.GetEndTime().AsTime()
+	}
+	rows, err := e.queries.GetLeaderboard(ctx, GetLeaderboardParams{
+		Tag:       req.GetTag(),
+		StartTime: startTime,
+		EndTime:   endTime,
+		RowLimit:  limit,
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	res := &enemy.GetLeaderboardResponse{}
+	enmys := make([]*enemy.Enemy, len(rows))
+	for i, row := range rows {
+		enmys[i] = toProto(Enemy{
+			ID:          row.ID,
+			EnemyID:     row.EnemyID,
+			FullName:    row.FullName,
+			Email:       row.Email,
+			Rating:      row.Rating,
+			LastUpdated: row.LastUpdated,
+			DeletedAt:   row.DeletedAt,
+			Version:     row.Version,
+		})
+		res.Entries = append(res.Entries, &enemy.LeaderboardEntry{
+			Enemy:      enmys[i],
+			Rank:       row.Rank,
+			Percentile: row.Percentile,
+		})
+	}
+	if err := e.loadDetails(ctx, e.queries, voter(ctx), enmys...); err != nil {
+		return nil, dbError(err)
+	}
+	return res, nil
+}
+
+// rank adds the current rank of the enemy of res to res. Deleted enemies
+// aren't ranked.
+func (e *EnemyStore) rank(ctx context.Context, res *enemy.GetEnemyResponse) error {
+	rank, err := e.queries.GetEnemyRank(ctx, res.GetEnemy().GetId())
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	res.Rank = rank
+	return nil
+}
@@ -0,0 +1,253 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/internal/filter"
+	"github.com/larwef/rpi-docker-test/internal/orderby"
+)
+
+// Columns filters are allowed to reference.
+var filterColumns = map[filter.Field]string{
+	filter.FieldName:        "full_name",
+	filter.FieldEmail:       "email",
+	filter.FieldRating:      "rating",
+	filter.FieldLastUpdated: "last_updated",
+}
+
+type sortColumn struct {
+	name  string
+	value func(Enemy) interface{}
+}
+
+// Columns results can be sorted by.
+var sortColumns = map[string]sortColumn{
+	"name":        {name: "full_name", value: func(e Enemy) interface{} { return e.FullName }},
+	"email":       {name: "email", value: func(e Enemy) interface{} { return e.Email }},
+	"rating":      {name: "rating", value: func(e Enemy) interface{} { return e.Rating }},
+	"lastUpdated": {name: "last_updated", value: func(e Enemy) interface{} { return e.LastUpdated }},
+}
+
+// The primary key is always the last sort column, making the ordering total
+// so that keyset pagination is stable.
+var idColumn = sortColumn{name: "id", value: func(e Enemy) interface{} { return e.ID }}
+
+type ListEnemiesParams struct {
+	// List the enemies as of this time. Nil for their current state.
+	ReadTime    *time.Time
+	ShowDeleted bool
+	Filter      filter.Expr
+	// Only list enemies with at least one of AnyTags, and all of AllTags.
+	AnyTags []string
+	AllTags []string
+	OrderBy []orderby.Term
+	// Last row of the previous page. Nil for the first page.
+	After    *Enemy
+	RowLimit int32
+}
+
+// ListEnemies is written by hand since sqlc can't generate queries with
+// dynamic WHERE and ORDER BY clauses.
+func (q *Queries) ListEnemies(ctx context.Context, arg ListEnemiesParams) ([]Enemy, error) {
+	var cols []sortColumn
+	var desc []bool
+	for _, term := range arg.OrderBy {
+		col, ok := sortColumns[term.Field]
+		if !ok {
+			return nil, fmt.Errorf("unsupported order by field %q", term.Field)
+		}
+		cols = append(cols, col)
+		desc = append(desc, term.Desc)
+	}
+	cols = append(cols, idColumn)
+	desc = append(desc, false)
+
+	var b queryBuilder
+	from := "enemies"
+	if arg.ReadTime != nil {
+		// The latest version of each enemy at the read time, named like the
+		// table so the rest of the query doesn't need to care.
+		from = fmt.Sprintf(`(
+	SELECT DISTINCT ON (v.enemy_id) e.id, e.enemy_id, v.full_name, v.email, v.rating, v.last_updated, v.deleted_at, v.version
+	FROM enemy_versions v
+	JOIN enemies e ON e.id = v.enemy_id
+	WHERE v.valid_from <= %s
+	ORDER BY v.enemy_id, v.valid_from DESC, v.id DESC
+) enemies`, b.arg(*arg.ReadTime))
+	}
+	conds := []string{
+		fmt.Sprintf("(deleted_at IS NULL OR %s)", b.arg(arg.ShowDeleted)),
+	}
+	if arg.Filter != nil {
+		cond, err := b.filter(arg.Filter)
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+	}
+	if len(arg.AnyTags) > 0 {
+		conds = append(conds, fmt.Sprintf("EXISTS (SELECT 1 FROM enemy_tags t WHERE t.enemy_id = enemies.id AND t.tag = ANY(%s::text::text[]))", b.arg(textArray(arg.AnyTags))))
+	}
+	if len(arg.AllTags) > 0 {
+		tags := distinct(arg.AllTags)
+		conds = append(conds, fmt.Sprintf("(SELECT count(*) FROM enemy_tags t WHERE t.enemy_id = enemies.id AND t.tag = ANY(%s::text::text[])) = %s", b.arg(textArray(tags)), b.arg(len(tags))))
+	}
+	if arg.After != nil {
+		conds = append(conds, b.after(cols, desc, *arg.After))
+	}
+	orderBy := make([]string, len(cols))
+	for i, col := range cols {
+		orderBy[i] = col.name
+		if desc[i] {
+			orderBy[i] += " DESC"
+		}
+	}
+	query := fmt.Sprintf(`SELECT id, enemy_id, full_name, email, rating, last_updated, deleted_at, version FROM %s
+WHERE %s
+ORDER BY %s
+LIMIT %s`, from, strings.Join(conds, "\nAND "), strings.Join(orderBy, ", "), b.arg(arg.RowLimit))
+
+	rows, err := q.db.QueryContext(ctx, query, b.args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Enemy
+	for rows.Next() {
+		var i Enemy
+		if err := rows.Scan(
+			&i.ID,
+			&i.EnemyID,
+			&i.FullName,
+			&i.Email,
+			&i.Rating,
+			&i.LastUpdated,
+			&i.DeletedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// queryBuilder collects the arguments of a query as it is built, so that
+// values never end up in the SQL text itself.
+type queryBuilder struct {
+	args []interface{}
+}
+
+// arg adds an argument and returns its placeholder.
+func (b *queryBuilder) arg(v interface{}) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// after matches the rows sorting after last, eg. for ascending columns a, b:
+// (a > last.a) OR (a = last.a AND b > last.b).
+func (b *queryBuilder) after(cols []sortColumn, desc []bool, last Enemy) string {
+	var ors []string
+	for i, col := range cols {
+		var ands []string
+		for _, prev := range cols[:i] {
+			ands = append(ands, fmt.Sprintf("%s = %s", prev.name, b.arg(prev.value(last))))
+		}
+		op := ">"
+		if desc[i] {
+			op = "<"
+		}
+		ands = append(ands, fmt.Sprintf("%s %s %s", col.name, op, b.arg(col.value(last))))
+		ors = append(ors, "("+strings.Join(ands, " AND ")+")")
+	}
+	return "(" + strings.Join(ors, " OR ") + ")"
+}
+
+func (b *queryBuilder) filter(expr filter.Expr) (string, error) {
+	switch e := expr.(type) {
+	case *filter.And:
+		return b.binary(e.Left, "AND", e.Right)
+	case *filter.Or:
+		return b.binary(e.Left, "OR", e.Right)
+	case *filter.Not:
+		cond, err := b.filter(e.Expr)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("(NOT %s)", cond), nil
+	case *filter.Restriction:
+		return b.restriction(e)
+	default:
+		return "", fmt.Errorf("unsupported filter expression %T", expr)
+	}
+}
+
+func (b *queryBuilder) binary(left filter.Expr, op string, right filter.Expr) (string, error) {
+	l, err := b.filter(left)
+	if err != nil {
+		return "", err
+	}
+	r, err := b.filter(right)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("(%s %s %s)", l, op, r), nil
+}
+
+func (b *queryBuilder) restriction(r *filter.Restriction) (string, error) {
+	col, ok := filterColumns[r.Field]
+	if !ok {
+		return "", fmt.Errorf("unsupported filter field %q", r.Field)
+	}
+	switch r.Op {
+	case filter.OpHas:
+		return fmt.Sprintf("%s ILIKE %s", col, b.arg("%"+escapeLike(fmt.Sprint(r.Value))+"%")), nil
+	case filter.OpEqual, filter.OpNotEqual:
+		if s, ok := r.Value.(string); ok && (strings.HasPrefix(s, "*") || strings.HasSuffix(s, "*")) {
+			op := "LIKE"
+			if r.Op == filter.OpNotEqual {
+				op = "NOT LIKE"
+			}
+			return fmt.Sprintf("%s %s %s", col, op, b.arg(wildcardToLike(s))), nil
+		}
+	}
+	return fmt.Sprintf("%s %s %s", col, r.Op, b.arg(r.Value)), nil
+}
+
+// wildcardToLike turns leading and trailing "*" into "%" and escapes
+// everything else.
+func wildcardToLike(s string) string {
+	prefix, suffix := "", ""
+	if strings.HasPrefix(s, "*") {
+		prefix, s = "%", s[1:]
+	}
+	if strings.HasSuffix(s, "*") {
+		suffix, s = "%", s[:len(s)-1]
+	}
+	return prefix + escapeLike(s) + suffix
+}
+
+func distinct(ss []string) []string {
+	seen := make(map[string]bool, len(ss))
+	var res []string
+	for _, s := range ss {
+		if !seen[s] {
+			seen[s] = true
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(s)
+}
@@ -3,14 +3,126 @@
 package storage
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 )
 
+type AuditLog struct {
+	ID        int32           `json:"id"`
+	EnemyID   string          `json:"enemy_id"`
+	Actor     string          `json:"actor"`
+	Method    string          `json:"method"`
+	Peer      string          `json:"peer"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type Confrontation struct {
+	ID              int32     `json:"id"`
+	ConfrontationID string    `json:"confrontation_id"`
+	EnemyID         int32     `json:"enemy_id"`
+	Outcome         int32     `json:"outcome"`
+	OccurredAt      time.Time `json:"occurred_at"`
+	Notes           string    `json:"notes"`
+	RatingBefore    float32   `json:"rating_before"`
+	RatingAfter     float32   `json:"rating_after"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
 type Enemy struct {
+	ID          int32        `json:"id"`
+	EnemyID     string       `json:"enemy_id"`
+	FullName    string       `json:"full_name"`
+	Email       string       `json:"email"`
+	Rating      float32      `json:"rating"`
+	LastUpdated time.Time    `json:"last_updated"`
+	DeletedAt   sql.NullTime `json:"deleted_at"`
+	Version     int32        `json:"version"`
+}
+
+type EnemyRatedAt struct {
+	EnemyID int32     `json:"enemy_id"`
+	RatedAt time.Time `json:"rated_at"`
+}
+
+type EnemyRatingHistory struct {
+	ID        int32     `json:"id"`
+	EnemyID   int32     `json:"enemy_id"`
+	Rating    float32   `json:"rating"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+type EnemyStaging struct {
+	LoadID   string  `json:"load_id"`
+	Line     int32   `json:"line"`
+	EnemyID  string  `json:"enemy_id"`
+	FullName string  `json:"full_name"`
+	Email    string  `json:"email"`
+	Rating   float32 `json:"rating"`
+}
+
+type EnemyTag struct {
+	EnemyID int32  `json:"enemy_id"`
+	Tag     string `json:"tag"`
+}
+
+type EnemyVersion struct {
+	ID          int32        `json:"id"`
+	EnemyID     int32        `json:"enemy_id"`
+	Version     int32        `json:"version"`
+	FullName    string       `json:"full_name"`
+	Email       string       `json:"email"`
+	Rating      float32      `json:"rating"`
+	LastUpdated time.Time    `json:"last_updated"`
+	DeletedAt   sql.NullTime `json:"deleted_at"`
+	ValidFrom   time.Time    `json:"valid_from"`
+}
+
+type Grievance struct {
 	ID          int32     `json:"id"`
-	EnemyID     string    `json:"enemy_id"`
-	FullName    string    `json:"full_name"`
-	Email       string    `json:"email"`
-	Rating      float32   `json:"rating"`
-	LastUpdated time.Time `json:"last_updated"`
+	GrievanceID string    `json:"grievance_id"`
+	EnemyID     int32     `json:"enemy_id"`
+	Text        string    `json:"text"`
+	Severity    int32     `json:"severity"`
+	OccurredAt  time.Time `json:"occurred_at"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	IdempotencyKey string    `json:"idempotency_key"`
+	RequestHash    string    `json:"request_hash"`
+	Response       []byte    `json:"response"`
+	CreatedAt      time.Time `json:"created_at"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+type RatingBasis struct {
+	EnemyID int32   `json:"enemy_id"`
+	Rating  float32 `json:"rating"`
+}
+
+type Relationship struct {
+	ID          int32     `json:"id"`
+	FromEnemyID int32     `json:"from_enemy_id"`
+	ToEnemyID   int32     `json:"to_enemy_id"`
+	Kind        int32     `json:"kind"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type Vote struct {
+	EnemyID int32     `json:"enemy_id"`
+	Voter   string    `json:"voter"`
+	Rating  float32   `json:"rating"`
+	CastAt  time.Time `json:"cast_at"`
+}
+
+type VoteAggregate struct {
+	EnemyID     int32   `json:"enemy_id"`
+	VoteCount   int32   `json:"vote_count"`
+	VoteSum     float64 `json:"vote_sum"`
+	Median      float32 `json:"median"`
+	TrimmedMean float32 `json:"trimmed_mean"`
 }
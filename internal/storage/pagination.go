@@ -0,0 +1,112 @@
+package storage
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+// Used when the request doesn't specify a page size.
+const defaultPageSize = 50
+
+// page fetches a page of rows using fetch, which is given the number of rows
+// to fetch and returns how many it got. One row more than the page size is
+// fetched to know whether there is another page. page returns how many of the
+// rows are on the page, and the token for the next page, made by token from the
+// index of the last row on the page. The token is empty on the last page.
+func page(pageSize int32, fetch func(limit int32) (int, error), token func(last int) string) (int, string, error) {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	n, err := fetch(pageSize + 1)
+	if err != nil {
+		return 0, "", err
+	}
+	if n <= int(pageSize) {
+		return n, "", nil
+	}
+	return int(pageSize), token(int(pageSize) - 1), nil
+}
+
+// pageToken is the cursor handed to clients as an opaque string. It holds the
+// sortable values of the last row on the previous page, together with the
+// ordering the page was produced with.
+type pageToken struct {
+	OrderBy     string    `json:"orderBy,omitempty"`
+	LastID      int32     `json:"lastId"`
+	Name        string    `json:"name,omitempty"`
+	Email       string    `json:"email,omitempty"`
+	Rating      float32   `json:"rating,omitempty"`
+	LastUpdated time.Time `json:"lastUpdated"`
+}
+
+func newPageToken(orderBy string, last Enemy) pageToken {
+	return pageToken{
+		OrderBy:     orderBy,
+		LastID:      last.ID,
+		Name:        last.FullName,
+		Email:       last.Email,
+		Rating:      last.Rating,
+		LastUpdated: last.LastUpdated,
+	}
+}
+
+// row returns the part of the last row the token remembers.
+func (p pageToken) row() *Enemy {
+	return &Enemy{
+		ID:          p.LastID,
+		FullName:    p.Name,
+		Email:       p.Email,
+		Rating:      p.Rating,
+		LastUpdated: p.LastUpdated,
+	}
+}
+
+func (p pageToken) encode() string {
+	return encodeToken(p)
+}
+
+func decodePageToken(s string) (pageToken, error) {
+	var p pageToken
+	return p, decodeToken(s, &p)
+}
+
+// historyPageToken is the cursor for GetRatingHistory.
+type historyPageToken struct {
+	ChangedAt time.Time `json:"changedAt"`
+	LastID    int32     `json:"lastId"`
+}
+
+func (p historyPageToken) encode() string {
+	return encodeToken(p)
+}
+
+func decodeHistoryPageToken(s string) (historyPageToken, error) {
+	var p historyPageToken
+	if s == "" {
+		return p, nil
+	}
+	return p, decodeToken(s, &p)
+}
+
+// grievancePageToken is the cursor for ListGrievances.
+type grievancePageToken struct {
+	OccurredAt time.Time `json:"occurredAt"`
+	LastID     int32     `json:"lastId"`
+}
+
+func encodeToken(v interface{}) string {
+	b, _ := json.Marshal(v)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeToken(s string, v interface{}) error {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return errorf(ErrInvalidArgument, "invalid page token")
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errorf(ErrInvalidArgument, "invalid page token")
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		pageSize  int32
+		rows      int
+		wantLimit int32
+		wantN     int
+		wantToken string
+	}{
+		{
+			name:      "Test last page",
+			pageSize:  3,
+			rows:      3,
+			wantLimit: 4,
+			wantN:     3,
+		},
+		{
+			name:      "Test more pages",
+			pageSize:  3,
+			rows:      4,
+			wantLimit: 4,
+			wantN:     3,
+			wantToken: "2",
+		},
+		{
+			name:      "Test default page size",
+			rows:      defaultPageSize + 1,
+			wantLimit: defaultPageSize + 1,
+			wantN:     defaultPageSize,
+			wantToken: strconv.Itoa(defaultPageSize - 1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var limit int32
+			n, token, err := page(test.pageSize, func(l int32) (int, error) {
+				limit = l
+				return test.rows, nil
+			}, strconv.Itoa)
+			assert.NoError(t, err)
+			assert.Equal(t, test.wantLimit, limit)
+			assert.Equal(t, test.wantN, n)
+			assert.Equal(t, test.wantToken, token)
+		})
+	}
+}
+
+func TestPage_Error(t *testing.T) {
+	someErr := errors.New("some error")
+	_, _, err := page(3, func(int32) (int, error) { return 0, someErr }, strconv.Itoa)
+	assert.Equal(t, someErr, err)
+}
@@ -5,13 +5,86 @@ package storage
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"time"
 )
 
+const addAuditEvent = `-- name: AddAuditEvent :exec
+INSERT INTO audit_log (enemy_id, actor, method, peer, before, after, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type AddAuditEventParams struct {
+	EnemyID   string          `json:"enemy_id"`
+	Actor     string          `json:"actor"`
+	Method    string          `json:"method"`
+	Peer      string          `json:"peer"`
+	Before    json.RawMessage `json:"before"`
+	After     json.RawMessage `json:"after"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+func (q *Queries) AddAuditEvent(ctx context.Context, arg AddAuditEventParams) error {
+	_, err := q.db.ExecContext(ctx, addAuditEvent,
+		arg.EnemyID,
+		arg.Actor,
+		arg.Method,
+		arg.Peer,
+		arg.Before,
+		arg.After,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const addConfrontation = `-- name: AddConfrontation :one
+INSERT INTO confrontations (confrontation_id, enemy_id, outcome, occurred_at, notes, rating_before, rating_after, created_at)
+VALUES ($1::text, $2::integer, $3::integer, $4::timestamp, $5::text, $6::real, $7::real, $8::timestamp)
+RETURNING id, confrontation_id, enemy_id, outcome, occurred_at, notes, rating_before, rating_after, created_at
+`
+
+type AddConfrontationParams struct {
+	ConfrontationID string    `json:"confrontation_id"`
+	EnemyID         int32     `json:"enemy_id"`
+	Outcome         int32     `json:"outcome"`
+	OccurredAt      time.Time `json:"occurred_at"`
+	Notes           string    `json:"notes"`
+	RatingBefore    float32   `json:"rating_before"`
+	RatingAfter     float32   `json:"rating_after"`
+	CreatedAt       time.Time `json:"created_at"`
+}
+
+func (q *Queries) AddConfrontation(ctx context.Context, arg AddConfrontationParams) (Confrontation, error) {
+	row := q.db.QueryRowContext(ctx, addConfrontation,
+		arg.ConfrontationID,
+		arg.EnemyID,
+		arg.Outcome,
+		arg.OccurredAt,
+		arg.Notes,
+		arg.RatingBefore,
+		arg.RatingAfter,
+		arg.CreatedAt,
+	)
+	var i Confrontation
+	err := row.Scan(
+		&i.ID,
+		&i.ConfrontationID,
+		&i.EnemyID,
+		&i.Outcome,
+		&i.OccurredAt,
+		&i.Notes,
+		&i.RatingBefore,
+		&i.RatingAfter,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
 const addEnemy = `-- name: AddEnemy :one
 INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated)
 VALUES ($1, $2, $3, $4, $5)
-RETURNING id, enemy_id, full_name, email, rating, last_updated
+RETURNING id, enemy_id, full_name, email, rating, last_updated, deleted_at, version
 `
 
 type AddEnemyParams struct {
@@ -38,35 +111,117 @@ func (q *Queries) AddEnemy(ctx context.Context, arg AddEnemyParams) (Enemy, erro
 		&i.Email,
 		&i.Rating,
 		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
 	)
 	return i, err
 }
 
-const getEnemy = `-- name: GetEnemy :one
-SELECT id, enemy_id, full_name, email, rating, last_updated FROM enemies
-WHERE enemy_id = $1
+const addEnemyTags = `-- name: AddEnemyTags :exec
+INSERT INTO enemy_tags (enemy_id, tag)
+SELECT $1::integer, unnest(($2::text)::text[])
+ON CONFLICT DO NOTHING
 `
 
-func (q *Queries) GetEnemy(ctx context.Context, enemyID string) (Enemy, error) {
-	row := q.db.QueryRowContext(ctx, getEnemy, enemyID)
-	var i Enemy
+type AddEnemyTagsParams struct {
+	ID   int32  `json:"id"`
+	Tags string `json:"tags"`
+}
+
+func (q *Queries) AddEnemyTags(ctx context.Context, arg AddEnemyTagsParams) error {
+	_, err := q.db.ExecContext(ctx, addEnemyTags, arg.ID, arg.Tags)
+	return err
+}
+
+const addEnemyVersion = `-- name: AddEnemyVersion :exec
+INSERT INTO enemy_versions (enemy_id, version, full_name, email, rating, last_updated, deleted_at, valid_from)
+SELECT id, version, full_name, email, rating, last_updated, deleted_at, $1::timestamp
+FROM enemies
+WHERE id = $2::integer
+`
+
+type AddEnemyVersionParams struct {
+	ValidFrom time.Time `json:"valid_from"`
+	ID        int32     `json:"id"`
+}
+
+func (q *Queries) AddEnemyVersion(ctx context.Context, arg AddEnemyVersionParams) error {
+	_, err := q.db.ExecContext(ctx, addEnemyVersion, arg.ValidFrom, arg.ID)
+	return err
+}
+
+const addGrievance = `-- name: AddGrievance :one
+INSERT INTO grievances (grievance_id, enemy_id, text, severity, occurred_at, created_by, created_at)
+SELECT $1::text, id, $2::text, $3::integer, $4::timestamp, $5::text, $6::timestamp
+FROM enemies
+WHERE enemy_id = $7::text
+AND deleted_at IS NULL
+RETURNING id, grievance_id, enemy_id, text, severity, occurred_at, created_by, created_at
+`
+
+type AddGrievanceParams struct {
+	GrievanceID string    `json:"grievance_id"`
+	Text        string    `json:"text"`
+	Severity    int32     `json:"severity"`
+	OccurredAt  time.Time `json:"occurred_at"`
+	CreatedBy   string    `json:"created_by"`
+	CreatedAt   time.Time `json:"created_at"`
+	EnemyID     string    `json:"enemy_id"`
+}
+
+func (q *Queries) AddGrievance(ctx context.Context, arg AddGrievanceParams) (Grievance, error) {
+	row := q.db.QueryRowContext(ctx, addGrievance,
+		arg.GrievanceID,
+		arg.Text,
+		arg.Severity,
+		arg.OccurredAt,
+		arg.CreatedBy,
+		arg.CreatedAt,
+		arg.EnemyID,
+	)
+	var i Grievance
 	err := row.Scan(
 		&i.ID,
+		&i.GrievanceID,
 		&i.EnemyID,
-		&i.FullName,
-		&i.Email,
-		&i.Rating,
-		&i.LastUpdated,
+		&i.Text,
+		&i.Severity,
+		&i.OccurredAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
 	)
 	return i, err
 }
 
-const listEnemies = `-- name: ListEnemies :many
-SELECT id, enemy_id, full_name, email, rating, last_updated FROM enemies
+const addRatingHistory = `-- name: AddRatingHistory :exec
+INSERT INTO enemy_rating_history (enemy_id, rating, changed_at)
+VALUES ($1, $2, $3)
+`
+
+type AddRatingHistoryParams struct {
+	EnemyID   int32     `json:"enemy_id"`
+	Rating    float32   `json:"rating"`
+	ChangedAt time.Time `json:"changed_at"`
+}
+
+func (q *Queries) AddRatingHistory(ctx context.Context, arg AddRatingHistoryParams) error {
+	_, err := q.db.ExecContext(ctx, addRatingHistory, arg.EnemyID, arg.Rating, arg.ChangedAt)
+	return err
+}
+
+const batchGetEnemies = `-- name: BatchGetEnemies :many
+SELECT id, enemy_id, full_name, email, rating, last_updated, deleted_at, version FROM enemies
+WHERE enemy_id = ANY(($1::text)::text[])
+AND (deleted_at IS NULL OR $2::boolean)
 `
 
-func (q *Queries) ListEnemies(ctx context.Context) ([]Enemy, error) {
-	rows, err := q.db.QueryContext(ctx, listEnemies)
+type BatchGetEnemiesParams struct {
+	EnemyIds    string `json:"enemy_ids"`
+	ShowDeleted bool   `json:"show_deleted"`
+}
+
+func (q *Queries) BatchGetEnemies(ctx context.Context, arg BatchGetEnemiesParams) ([]Enemy, error) {
+	rows, err := q.db.QueryContext(ctx, batchGetEnemies, arg.EnemyIds, arg.ShowDeleted)
 	if err != nil {
 		return nil, err
 	}
@@ -81,6 +236,8 @@ func (q *Queries) ListEnemies(ctx context.Context) ([]Enemy, error) {
 			&i.Email,
 			&i.Rating,
 			&i.LastUpdated,
+			&i.DeletedAt,
+			&i.Version,
 		); err != nil {
 			return nil, err
 		}
@@ -95,33 +252,335 @@ func (q *Queries) ListEnemies(ctx context.Context) ([]Enemy, error) {
 	return items, nil
 }
 
-const updateEnemy = `-- name: UpdateEnemy :one
+const castVote = `-- name: CastVote :exec
+INSERT INTO votes (enemy_id, voter, rating, cast_at)
+VALUES ($1::integer, $2::text, $3::real, $4::timestamp)
+ON CONFLICT (enemy_id, voter) DO UPDATE
+SET rating = EXCLUDED.rating,
+    cast_at = EXCLUDED.cast_at
+`
+
+type CastVoteParams struct {
+	EnemyID int32     `json:"enemy_id"`
+	Voter   string    `json:"voter"`
+	Rating  float32   `json:"rating"`
+	CastAt  time.Time `json:"cast_at"`
+}
+
+func (q *Queries) CastVote(ctx context.Context, arg CastVoteParams) error {
+	_, err := q.db.ExecContext(ctx, castVote,
+		arg.EnemyID,
+		arg.Voter,
+		arg.Rating,
+		arg.CastAt,
+	)
+	return err
+}
+
+const claimIdempotencyKey = `-- name: ClaimIdempotencyKey :execrows
+INSERT INTO idempotency_keys (idempotency_key, request_hash, created_at, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (idempotency_key) DO NOTHING
+`
+
+type ClaimIdempotencyKeyParams struct {
+	IdempotencyKey string    `json:"idempotency_key"`
+	RequestHash    string    `json:"request_hash"`
+	CreatedAt      time.Time `json:"created_at"`
+	ExpiresAt      time.Time `json:"expires_at"`
+}
+
+func (q *Queries) ClaimIdempotencyKey(ctx context.Context, arg ClaimIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimIdempotencyKey,
+		arg.IdempotencyKey,
+		arg.RequestHash,
+		arg.CreatedAt,
+		arg.ExpiresAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const clearEnemyTags = `-- name: ClearEnemyTags :exec
+DELETE FROM enemy_tags
+WHERE enemy_id = $1
+`
+
+func (q *Queries) ClearEnemyTags(ctx context.Context, enemyID int32) error {
+	_, err := q.db.ExecContext(ctx, clearEnemyTags, enemyID)
+	return err
+}
+
+const countGrievances = `-- name: CountGrievances :one
+SELECT count(*)::integer FROM grievances g
+JOIN enemies e ON e.id = g.enemy_id
+WHERE e.enemy_id = $1::text
+`
+
+func (q *Queries) CountGrievances(ctx context.Context, enemyID string) (int32, error) {
+	row := q.db.QueryRowContext(ctx, countGrievances, enemyID)
+	var column_1 int32
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const deleteEnemy = `-- name: DeleteEnemy :one
 UPDATE enemies
 SET
-    full_name = COALESCE(NULLIF($1::text, ''), full_name),
-    email = COALESCE(NULLIF($2::text, ''), email),
-    rating = COALESCE(NULLIF($3::real, 0.0), rating),
-    last_updated = $4::timestamp
-WHERE enemy_id = $5::text
-RETURNING id, enemy_id, full_name, email, rating, last_updated
+    deleted_at = $1::timestamp,
+    version = version + 1
+WHERE enemy_id = $2::text
+AND deleted_at IS NULL
+RETURNING id, enemy_id, full_name, email, rating, last_updated, deleted_at, version
 `
 
-type UpdateEnemyParams struct {
-	FullName    string    `json:"full_name"`
-	Email       string    `json:"email"`
-	Rating      float32   `json:"rating"`
-	LastUpdated time.Time `json:"last_updated"`
-	EnemyID     string    `json:"enemy_id"`
+type DeleteEnemyParams struct {
+	DeletedAt time.Time `json:"deleted_at"`
+	EnemyID   string    `json:"enemy_id"`
 }
 
-func (q *Queries) UpdateEnemy(ctx context.Context, arg UpdateEnemyParams) (Enemy, error) {
-	row := q.db.QueryRowContext(ctx, updateEnemy,
-		arg.FullName,
-		arg.Email,
-		arg.Rating,
-		arg.LastUpdated,
-		arg.EnemyID,
+func (q *Queries) DeleteEnemy(ctx context.Context, arg DeleteEnemyParams) (Enemy, error) {
+	row := q.db.QueryRowContext(ctx, deleteEnemy, arg.DeletedAt, arg.EnemyID)
+	var i Enemy
+	err := row.Scan(
+		&i.ID,
+		&i.EnemyID,
+		&i.FullName,
+		&i.Email,
+		&i.Rating,
+		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM idempotency_keys
+WHERE expires_at <= $1::timestamp
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) error {
+	_, err := q.db.ExecContext(ctx, deleteExpiredIdempotencyKeys, now)
+	return err
+}
+
+const deleteGrievance = `-- name: DeleteGrievance :one
+DELETE FROM grievances g
+USING enemies e
+WHERE e.id = g.enemy_id
+AND e.enemy_id = $1::text
+AND g.grievance_id = $2::text
+RETURNING g.id, g.grievance_id, g.enemy_id, g.text, g.severity, g.occurred_at, g.created_by, g.created_at
+`
+
+type DeleteGrievanceParams struct {
+	EnemyID     string `json:"enemy_id"`
+	GrievanceID string `json:"grievance_id"`
+}
+
+func (q *Queries) DeleteGrievance(ctx context.Context, arg DeleteGrievanceParams) (Grievance, error) {
+	row := q.db.QueryRowContext(ctx, deleteGrievance, arg.EnemyID, arg.GrievanceID)
+	var i Grievance
+	err := row.Scan(
+		&i.ID,
+		&i.GrievanceID,
+		&i.EnemyID,
+		&i.Text,
+		&i.Severity,
+		&i.OccurredAt,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteStagedEnemies = `-- name: DeleteStagedEnemies :exec
+DELETE FROM enemy_staging
+WHERE load_id = $1
+`
+
+func (q *Queries) DeleteStagedEnemies(ctx context.Context, loadID string) error {
+	_, err := q.db.ExecContext(ctx, deleteStagedEnemies, loadID)
+	return err
+}
+
+const getDailyChanges = `-- name: GetDailyChanges :many
+SELECT d.day::timestamp AS day,
+    count(v.id) FILTER (WHERE v.version = 1)::integer AS added,
+    count(v.id) FILTER (WHERE v.version > 1 AND v.deleted_at IS NULL)::integer AS updated
+FROM (
+    SELECT generate_series(date_trunc('day', $1::timestamp), $2::timestamp, interval '1 day') AS day
+) d
+LEFT JOIN enemy_versions v ON v.valid_from >= d.day
+    AND v.valid_from < d.day + interval '1 day'
+    AND v.valid_from >= $1::timestamp
+    AND v.valid_from < $2::timestamp
+WHERE d.day < $2::timestamp
+GROUP BY d.day
+ORDER BY d.day
+`
+
+type GetDailyChangesParams struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+}
+
+type GetDailyChangesRow struct {
+	Day     time.Time `json:"day"`
+	Added   int32     `json:"added"`
+	Updated int32     `json:"updated"`
+}
+
+// Counts the enemies added and updated each day of the time window, in UTC.
+// Versions are numbered from 1, so any later version is an update, unless it
+// is a delete.
+func (q *Queries) GetDailyChanges(ctx context.Context, arg GetDailyChangesParams) ([]GetDailyChangesRow, error) {
+	rows, err := q.db.QueryContext(ctx, getDailyChanges, arg.StartTime, arg.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDailyChangesRow
+	for rows.Next() {
+		var i GetDailyChangesRow
+		if err := rows.Scan(&i.Day, &i.Added, &i.Updated); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEnemy = `-- name: GetEnemy :one
+SELECT id, enemy_id, full_name, email, rating, last_updated, deleted_at, version FROM enemies
+WHERE enemy_id = $1::text
+AND (deleted_at IS NULL OR $2::boolean)
+`
+
+type GetEnemyParams struct {
+	EnemyID     string `json:"enemy_id"`
+	ShowDeleted bool   `json:"show_deleted"`
+}
+
+func (q *Queries) GetEnemy(ctx context.Context, arg GetEnemyParams) (Enemy, error) {
+	row := q.db.QueryRowContext(ctx, getEnemy, arg.EnemyID, arg.ShowDeleted)
+	var i Enemy
+	err := row.Scan(
+		&i.ID,
+		&i.EnemyID,
+		&i.FullName,
+		&i.Email,
+		&i.Rating,
+		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getEnemyAsOf = `-- name: GetEnemyAsOf :one
+SELECT e.id, e.enemy_id, v.full_name, v.email, v.rating, v.last_updated, v.deleted_at, v.version
+FROM enemy_versions v
+JOIN enemies e ON e.id = v.enemy_id
+WHERE e.enemy_id = $1::text
+AND v.valid_from <= $2::timestamp
+ORDER BY v.valid_from DESC, v.id DESC
+LIMIT 1
+`
+
+type GetEnemyAsOfParams struct {
+	EnemyID  string    `json:"enemy_id"`
+	ReadTime time.Time `json:"read_time"`
+}
+
+type GetEnemyAsOfRow struct {
+	ID          int32        `json:"id"`
+	EnemyID     string       `json:"enemy_id"`
+	FullName    string       `json:"full_name"`
+	Email       string       `json:"email"`
+	Rating      float32      `json:"rating"`
+	LastUpdated time.Time    `json:"last_updated"`
+	DeletedAt   sql.NullTime `json:"deleted_at"`
+	Version     int32        `json:"version"`
+}
+
+func (q *Queries) GetEnemyAsOf(ctx context.Context, arg GetEnemyAsOfParams) (GetEnemyAsOfRow, error) {
+	row := q.db.QueryRowContext(ctx, getEnemyAsOf, arg.EnemyID, arg.ReadTime)
+	var i GetEnemyAsOfRow
+	err := row.Scan(
+		&i.ID,
+		&i.EnemyID,
+		&i.FullName,
+		&i.Email,
+		&i.Rating,
+		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getEnemyByEmail = `-- name: GetEnemyByEmail :one
+SELECT id, enemy_id, full_name, email, rating, last_updated, deleted_at, version FROM enemies
+WHERE lower(email) = lower($1::text)
+AND deleted_at IS NULL
+`
+
+func (q *Queries) GetEnemyByEmail(ctx context.Context, email string) (Enemy, error) {
+	row := q.db.QueryRowContext(ctx, getEnemyByEmail, email)
+	var i Enemy
+	err := row.Scan(
+		&i.ID,
+		&i.EnemyID,
+		&i.FullName,
+		&i.Email,
+		&i.Rating,
+		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getEnemyByIDForUpdate = `-- name: GetEnemyByIDForUpdate :one
+SELECT id, enemy_id, full_name, email, rating, last_updated, deleted_at, version FROM enemies
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetEnemyByIDForUpdate(ctx context.Context, id int32) (Enemy, error) {
+	row := q.db.QueryRowContext(ctx, getEnemyByIDForUpdate, id)
+	var i Enemy
+	err := row.Scan(
+		&i.ID,
+		&i.EnemyID,
+		&i.FullName,
+		&i.Email,
+		&i.Rating,
+		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
 	)
+	return i, err
+}
+
+const getEnemyForUpdate = `-- name: GetEnemyForUpdate :one
+SELECT id, enemy_id, full_name, email, rating, last_updated, deleted_at, version FROM enemies
+WHERE enemy_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetEnemyForUpdate(ctx context.Context, enemyID string) (Enemy, error) {
+	row := q.db.QueryRowContext(ctx, getEnemyForUpdate, enemyID)
 	var i Enemy
 	err := row.Scan(
 		&i.ID,
@@ -130,6 +589,1173 @@ func (q *Queries) UpdateEnemy(ctx context.Context, arg UpdateEnemyParams) (Enemy
 		&i.Email,
 		&i.Rating,
 		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
 	)
 	return i, err
 }
+
+const getEnemyNetwork = `-- name: GetEnemyNetwork :many
+WITH RECURSIVE network (id, depth) AS (
+    SELECT e.id, 0 FROM enemies e
+    WHERE e.enemy_id = $1::text
+    AND e.deleted_at IS NULL
+  UNION
+    SELECT e.id, n.depth + 1
+    FROM network n
+    JOIN relationships r ON n.id IN (r.from_enemy_id, r.to_enemy_id)
+    JOIN enemies e ON e.id = CASE WHEN r.from_enemy_id = n.id THEN r.to_enemy_id ELSE r.from_enemy_id END
+    WHERE n.depth < $2::integer
+    AND e.deleted_at IS NULL
+    AND (cardinality(($3::text)::integer[]) = 0 OR r.kind = ANY(($3::text)::integer[]))
+)
+SELECT e.id, e.enemy_id, e.full_name, e.email, e.rating, e.last_updated, e.deleted_at, e.version, min(n.depth)::integer AS depth
+FROM network n
+JOIN enemies e ON e.id = n.id
+GROUP BY e.id
+ORDER BY depth, e.id
+`
+
+type GetEnemyNetworkParams struct {
+	EnemyID  string `json:"enemy_id"`
+	MaxDepth int32  `json:"max_depth"`
+	Kinds    string `json:"kinds"`
+}
+
+type GetEnemyNetworkRow struct {
+	ID          int32        `json:"id"`
+	EnemyID     string       `json:"enemy_id"`
+	FullName    string       `json:"full_name"`
+	Email       string       `json:"email"`
+	Rating      float32      `json:"rating"`
+	LastUpdated time.Time    `json:"last_updated"`
+	DeletedAt   sql.NullTime `json:"deleted_at"`
+	Version     int32        `json:"version"`
+	Depth       int32        `json:"depth"`
+}
+
+// Walks relationships in both directions from an enemy, skipping deleted
+// enemies, and returns every enemy reached along with its distance from the
+// start. An empty kinds array follows relationships of every kind.
+func (q *Queries) GetEnemyNetwork(ctx context.Context, arg GetEnemyNetworkParams) ([]GetEnemyNetworkRow, error) {
+	rows, err := q.db.QueryContext(ctx, getEnemyNetwork, arg.EnemyID, arg.MaxDepth, arg.Kinds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetEnemyNetworkRow
+	for rows.Next() {
+		var i GetEnemyNetworkRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EnemyID,
+			&i.FullName,
+			&i.Email,
+			&i.Rating,
+			&i.LastUpdated,
+			&i.DeletedAt,
+			&i.Version,
+			&i.Depth,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getEnemyRank = `-- name: GetEnemyRank :one
+SELECT ranked.rank FROM (
+    SELECT e.enemy_id, (dense_rank() OVER (ORDER BY e.rating DESC, r.rated_at, e.id))::integer AS rank
+    FROM enemies e
+    JOIN enemy_rated_at r ON r.enemy_id = e.id
+    WHERE e.deleted_at IS NULL
+) ranked
+WHERE ranked.enemy_id = $1::text
+`
+
+// The rank of an enemy that isn't deleted, among all enemies that aren't,
+// in the order of GetLeaderboard.
+func (q *Queries) GetEnemyRank(ctx context.Context, enemyID string) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getEnemyRank, enemyID)
+	var rank int32
+	err := row.Scan(&rank)
+	return rank, err
+}
+
+const getEnemyVersion = `-- name: GetEnemyVersion :one
+SELECT e.id, e.enemy_id, v.full_name, v.email, v.rating, v.last_updated, v.deleted_at, v.version
+FROM enemy_versions v
+JOIN enemies e ON e.id = v.enemy_id
+WHERE e.enemy_id = $1::text
+AND v.version = $2::integer
+ORDER BY v.id DESC
+LIMIT 1
+`
+
+type GetEnemyVersionParams struct {
+	EnemyID string `json:"enemy_id"`
+	Version int32  `json:"version"`
+}
+
+type GetEnemyVersionRow struct {
+	ID          int32        `json:"id"`
+	EnemyID     string       `json:"enemy_id"`
+	FullName    string       `json:"full_name"`
+	Email       string       `json:"email"`
+	Rating      float32      `json:"rating"`
+	LastUpdated time.Time    `json:"last_updated"`
+	DeletedAt   sql.NullTime `json:"deleted_at"`
+	Version     int32        `json:"version"`
+}
+
+func (q *Queries) GetEnemyVersion(ctx context.Context, arg GetEnemyVersionParams) (GetEnemyVersionRow, error) {
+	row := q.db.QueryRowContext(ctx, getEnemyVersion, arg.EnemyID, arg.Version)
+	var i GetEnemyVersionRow
+	err := row.Scan(
+		&i.ID,
+		&i.EnemyID,
+		&i.FullName,
+		&i.Email,
+		&i.Rating,
+		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT idempotency_key, request_hash, response, created_at, expires_at FROM idempotency_keys
+WHERE idempotency_key = $1
+`
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, idempotencyKey string) (IdempotencyKey, error) {
+	row := q.db.QueryRowContext(ctx, getIdempotencyKey, idempotencyKey)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.IdempotencyKey,
+		&i.RequestHash,
+		&i.Response,
+		&i.CreatedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getLeaderboard = `-- name: GetLeaderboard :many
+SELECT e.id, e.enemy_id, e.full_name, e.email, e.rating, e.last_updated, e.deleted_at, e.version,
+    (dense_rank() OVER (ORDER BY e.rating DESC, r.rated_at, e.id))::integer AS rank,
+    (100 * percent_rank() OVER (ORDER BY e.rating))::double precision AS percentile
+FROM enemies e
+JOIN enemy_rated_at r ON r.enemy_id = e.id
+WHERE e.deleted_at IS NULL
+AND ($1::text = '' OR EXISTS (SELECT 1 FROM enemy_tags t WHERE t.enemy_id = e.id AND t.tag = $1::text))
+AND e.last_updated >= $2::timestamp
+AND e.last_updated < $3::timestamp
+ORDER BY e.rating DESC, r.rated_at, e.id
+LIMIT $4::integer
+`
+
+type GetLeaderboardParams struct {
+	Tag       string    `json:"tag"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	RowLimit  int32     `json:"row_limit"`
+}
+
+type GetLeaderboardRow struct {
+	ID          int32        `json:"id"`
+	EnemyID     string       `json:"enemy_id"`
+	FullName    string       `json:"full_name"`
+	Email       string       `json:"email"`
+	Rating      float32      `json:"rating"`
+	LastUpdated time.Time    `json:"last_updated"`
+	DeletedAt   sql.NullTime `json:"deleted_at"`
+	Version     int32        `json:"version"`
+	Rank        int32        `json:"rank"`
+	Percentile  float64      `json:"percentile"`
+}
+
+// Ranks the enemies that aren't deleted by rating, highest first, optionally
+// only those with a tag or last updated within a time window. Ties are broken
+// by who got their rating first, and then by id, like GetEnemyRank.
+func (q *Queries) GetLeaderboard(ctx context.Context, arg GetLeaderboardParams) ([]GetLeaderboardRow, error) {
+	rows, err := q.db.QueryContext(ctx, getLeaderboard,
+		arg.Tag,
+		arg.StartTime,
+		arg.EndTime,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetLeaderboardRow
+	for rows.Next() {
+		var i GetLeaderboardRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.EnemyID,
+			&i.FullName,
+			&i.Email,
+			&i.Rating,
+			&i.LastUpdated,
+			&i.DeletedAt,
+			&i.Version,
+			&i.Rank,
+			&i.Percentile,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRatingHistogram = `-- name: GetRatingHistogram :many
+SELECT width_bucket(rating, ($1::text)::real[])::integer AS bucket, count(*)::integer AS count
+FROM enemies
+WHERE deleted_at IS NULL
+GROUP BY 1
+ORDER BY 1
+`
+
+type GetRatingHistogramRow struct {
+	Bucket int32 `json:"bucket"`
+	Count  int32 `json:"count"`
+}
+
+// Bucket 0 is below the first boundary, and bucket i is at or above the i-th
+// boundary but below the next one. Empty buckets are left out.
+func (q *Queries) GetRatingHistogram(ctx context.Context, boundaries string) ([]GetRatingHistogramRow, error) {
+	rows, err := q.db.QueryContext(ctx, getRatingHistogram, boundaries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetRatingHistogramRow
+	for rows.Next() {
+		var i GetRatingHistogramRow
+		if err := rows.Scan(&i.Bucket, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRatingStats = `-- name: GetRatingStats :one
+SELECT count(*)::integer AS count,
+    coalesce(avg(rating), 0)::double precision AS mean,
+    coalesce(percentile_cont(0.5) WITHIN GROUP (ORDER BY rating), 0)::double precision AS median,
+    coalesce(percentile_cont(0.9) WITHIN GROUP (ORDER BY rating), 0)::double precision AS p90
+FROM enemies
+WHERE deleted_at IS NULL
+`
+
+type GetRatingStatsRow struct {
+	Count  int32   `json:"count"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+	P90    float64 `json:"p90"`
+}
+
+func (q *Queries) GetRatingStats(ctx context.Context) (GetRatingStatsRow, error) {
+	row := q.db.QueryRowContext(ctx, getRatingStats)
+	var i GetRatingStatsRow
+	err := row.Scan(
+		&i.Count,
+		&i.Mean,
+		&i.Median,
+		&i.P90,
+	)
+	return i, err
+}
+
+const getStagedEnemiesForUpdate = `-- name: GetStagedEnemiesForUpdate :many
+SELECT id, enemy_id, full_name, email, rating, last_updated, deleted_at, version FROM enemies
+WHERE lower(email) IN (SELECT lower(email) FROM enemy_staging WHERE load_id = $1)
+AND deleted_at IS NULL
+FOR UPDATE
+`
+
+func (q *Queries) GetStagedEnemiesForUpdate(ctx context.Context, loadID string) ([]Enemy, error) {
+	rows, err := q.db.QueryContext(ctx, getStagedEnemiesForUpdate, loadID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Enemy
+	for rows.Next() {
+		var i Enemy
+		if err := rows.Scan(
+			&i.ID,
+			&i.EnemyID,
+			&i.FullName,
+			&i.Email,
+			&i.Rating,
+			&i.LastUpdated,
+			&i.DeletedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getVoteForUpdate = `-- name: GetVoteForUpdate :one
+SELECT rating FROM votes
+WHERE enemy_id = $1::integer
+AND voter = $2::text
+FOR UPDATE
+`
+
+type GetVoteForUpdateParams struct {
+	EnemyID int32  `json:"enemy_id"`
+	Voter   string `json:"voter"`
+}
+
+func (q *Queries) GetVoteForUpdate(ctx context.Context, arg GetVoteForUpdateParams) (float32, error) {
+	row := q.db.QueryRowContext(ctx, getVoteForUpdate, arg.EnemyID, arg.Voter)
+	var rating float32
+	err := row.Scan(&rating)
+	return rating, err
+}
+
+const hasConfrontationsAfter = `-- name: HasConfrontationsAfter :one
+SELECT EXISTS (
+    SELECT 1 FROM confrontations
+    WHERE enemy_id = $1::integer
+    AND occurred_at > $2::timestamp
+)
+`
+
+type HasConfrontationsAfterParams struct {
+	EnemyID    int32     `json:"enemy_id"`
+	OccurredAt time.Time `json:"occurred_at"`
+}
+
+func (q *Queries) HasConfrontationsAfter(ctx context.Context, arg HasConfrontationsAfterParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, hasConfrontationsAfter, arg.EnemyID, arg.OccurredAt)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const linkEnemies = `-- name: LinkEnemies :one
+INSERT INTO relationships (from_enemy_id, to_enemy_id, kind, created_at)
+VALUES ($1::integer, $2::integer, $3::integer, $4::timestamp)
+RETURNING id, from_enemy_id, to_enemy_id, kind, created_at
+`
+
+type LinkEnemiesParams struct {
+	FromID    int32     `json:"from_id"`
+	ToID      int32     `json:"to_id"`
+	Kind      int32     `json:"kind"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (q *Queries) LinkEnemies(ctx context.Context, arg LinkEnemiesParams) (Relationship, error) {
+	row := q.db.QueryRowContext(ctx, linkEnemies,
+		arg.FromID,
+		arg.ToID,
+		arg.Kind,
+		arg.CreatedAt,
+	)
+	var i Relationship
+	err := row.Scan(
+		&i.ID,
+		&i.FromEnemyID,
+		&i.ToEnemyID,
+		&i.Kind,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAuditEvents = `-- name: ListAuditEvents :many
+SELECT id, enemy_id, actor, method, peer, before, after, created_at FROM audit_log
+WHERE ($1::text = '' OR enemy_id = $1::text)
+AND ($2::text = '' OR actor = $2::text)
+AND created_at >= $3::timestamp
+AND created_at < $4::timestamp
+AND id < $5::integer
+ORDER BY id DESC
+LIMIT $6::integer
+`
+
+type ListAuditEventsParams struct {
+	EnemyID   string    `json:"enemy_id"`
+	Actor     string    `json:"actor"`
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	BeforeID  int32     `json:"before_id"`
+	RowLimit  int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListAuditEvents(ctx context.Context, arg ListAuditEventsParams) ([]AuditLog, error) {
+	rows, err := q.db.QueryContext(ctx, listAuditEvents,
+		arg.EnemyID,
+		arg.Actor,
+		arg.StartTime,
+		arg.EndTime,
+		arg.BeforeID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditLog
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.EnemyID,
+			&i.Actor,
+			&i.Method,
+			&i.Peer,
+			&i.Before,
+			&i.After,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConfrontationsForUpdate = `-- name: ListConfrontationsForUpdate :many
+SELECT c.id, c.confrontation_id, c.enemy_id, c.outcome, c.occurred_at, c.notes, c.rating_before, c.rating_after, c.created_at, b.rating AS base_rating FROM confrontations c
+JOIN enemies e ON e.id = c.enemy_id
+JOIN rating_bases b ON b.enemy_id = c.enemy_id
+WHERE $1::boolean OR c.enemy_id = $2::integer
+ORDER BY c.enemy_id, c.occurred_at, c.created_at, c.id
+FOR UPDATE
+`
+
+type ListConfrontationsForUpdateParams struct {
+	AllEnemies bool  `json:"all_enemies"`
+	EnemyID    int32 `json:"enemy_id"`
+}
+
+type ListConfrontationsForUpdateRow struct {
+	ID              int32     `json:"id"`
+	ConfrontationID string    `json:"confrontation_id"`
+	EnemyID         int32     `json:"enemy_id"`
+	Outcome         int32     `json:"outcome"`
+	OccurredAt      time.Time `json:"occurred_at"`
+	Notes           string    `json:"notes"`
+	RatingBefore    float32   `json:"rating_before"`
+	RatingAfter     float32   `json:"rating_after"`
+	CreatedAt       time.Time `json:"created_at"`
+	BaseRating      float32   `json:"base_rating"`
+}
+
+// The confrontations of every enemy, or only of enemy_id, in the order they
+// are replayed in along with the rating the replay starts from, locking them
+// and their enemies.
+func (q *Queries) ListConfrontationsForUpdate(ctx context.Context, arg ListConfrontationsForUpdateParams) ([]ListConfrontationsForUpdateRow, error) {
+	rows, err := q.db.QueryContext(ctx, listConfrontationsForUpdate, arg.AllEnemies, arg.EnemyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListConfrontationsForUpdateRow
+	for rows.Next() {
+		var i ListConfrontationsForUpdateRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ConfrontationID,
+			&i.EnemyID,
+			&i.Outcome,
+			&i.OccurredAt,
+			&i.Notes,
+			&i.RatingBefore,
+			&i.RatingAfter,
+			&i.CreatedAt,
+			&i.BaseRating,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEnemyTags = `-- name: ListEnemyTags :many
+SELECT e.enemy_id, t.tag FROM enemy_tags t
+JOIN enemies e ON e.id = t.enemy_id
+WHERE e.enemy_id = ANY(($1::text)::text[])
+ORDER BY e.enemy_id, t.tag
+`
+
+type ListEnemyTagsRow struct {
+	EnemyID string `json:"enemy_id"`
+	Tag     string `json:"tag"`
+}
+
+func (q *Queries) ListEnemyTags(ctx context.Context, enemyIds string) ([]ListEnemyTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listEnemyTags, enemyIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListEnemyTagsRow
+	for rows.Next() {
+		var i ListEnemyTagsRow
+		if err := rows.Scan(&i.EnemyID, &i.Tag); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listGrievances = `-- name: ListGrievances :many
+SELECT g.id, g.grievance_id, g.enemy_id, g.text, g.severity, g.occurred_at, g.created_by, g.created_at FROM grievances g
+JOIN enemies e ON e.id = g.enemy_id
+WHERE e.enemy_id = $1::text
+AND (g.occurred_at, g.id) < ($2::timestamp, $3::integer)
+ORDER BY g.occurred_at DESC, g.id DESC
+LIMIT $4::integer
+`
+
+type ListGrievancesParams struct {
+	EnemyID          string    `json:"enemy_id"`
+	BeforeOccurredAt time.Time `json:"before_occurred_at"`
+	BeforeID         int32     `json:"before_id"`
+	RowLimit         int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListGrievances(ctx context.Context, arg ListGrievancesParams) ([]Grievance, error) {
+	rows, err := q.db.QueryContext(ctx, listGrievances,
+		arg.EnemyID,
+		arg.BeforeOccurredAt,
+		arg.BeforeID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Grievance
+	for rows.Next() {
+		var i Grievance
+		if err := rows.Scan(
+			&i.ID,
+			&i.GrievanceID,
+			&i.EnemyID,
+			&i.Text,
+			&i.Severity,
+			&i.OccurredAt,
+			&i.CreatedBy,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRatingHistory = `-- name: ListRatingHistory :many
+SELECT h.id, h.enemy_id, h.rating, h.changed_at FROM enemy_rating_history h
+JOIN enemies e ON e.id = h.enemy_id
+WHERE e.enemy_id = $1::text
+AND h.changed_at >= $2::timestamp
+AND h.changed_at < $3::timestamp
+AND (h.changed_at, h.id) > ($4::timestamp, $5::integer)
+ORDER BY h.changed_at, h.id
+LIMIT $6::integer
+`
+
+type ListRatingHistoryParams struct {
+	EnemyID        string    `json:"enemy_id"`
+	StartTime      time.Time `json:"start_time"`
+	EndTime        time.Time `json:"end_time"`
+	AfterChangedAt time.Time `json:"after_changed_at"`
+	AfterID        int32     `json:"after_id"`
+	RowLimit       int32     `json:"row_limit"`
+}
+
+func (q *Queries) ListRatingHistory(ctx context.Context, arg ListRatingHistoryParams) ([]EnemyRatingHistory, error) {
+	rows, err := q.db.QueryContext(ctx, listRatingHistory,
+		arg.EnemyID,
+		arg.StartTime,
+		arg.EndTime,
+		arg.AfterChangedAt,
+		arg.AfterID,
+		arg.RowLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []EnemyRatingHistory
+	for rows.Next() {
+		var i EnemyRatingHistory
+		if err := rows.Scan(
+			&i.ID,
+			&i.EnemyID,
+			&i.Rating,
+			&i.ChangedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listRelationshipsBetween = `-- name: ListRelationshipsBetween :many
+SELECT r.kind, r.created_at, f.enemy_id AS from_enemy_id, t.enemy_id AS to_enemy_id
+FROM relationships r
+JOIN enemies f ON f.id = r.from_enemy_id
+JOIN enemies t ON t.id = r.to_enemy_id
+WHERE r.from_enemy_id = ANY(($1::text)::integer[])
+AND r.to_enemy_id = ANY(($1::text)::integer[])
+AND (cardinality(($2::text)::integer[]) = 0 OR r.kind = ANY(($2::text)::integer[]))
+ORDER BY r.id
+`
+
+type ListRelationshipsBetweenParams struct {
+	Ids   string `json:"ids"`
+	Kinds string `json:"kinds"`
+}
+
+type ListRelationshipsBetweenRow struct {
+	Kind        int32     `json:"kind"`
+	CreatedAt   time.Time `json:"created_at"`
+	FromEnemyID string    `json:"from_enemy_id"`
+	ToEnemyID   string    `json:"to_enemy_id"`
+}
+
+// Relationships where both enemies are among ids.
+func (q *Queries) ListRelationshipsBetween(ctx context.Context, arg ListRelationshipsBetweenParams) ([]ListRelationshipsBetweenRow, error) {
+	rows, err := q.db.QueryContext(ctx, listRelationshipsBetween, arg.Ids, arg.Kinds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRelationshipsBetweenRow
+	for rows.Next() {
+		var i ListRelationshipsBetweenRow
+		if err := rows.Scan(
+			&i.Kind,
+			&i.CreatedAt,
+			&i.FromEnemyID,
+			&i.ToEnemyID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTags = `-- name: ListTags :many
+SELECT t.tag, count(*)::integer AS count
+FROM enemy_tags t
+JOIN enemies e ON e.id = t.enemy_id
+WHERE e.deleted_at IS NULL
+GROUP BY t.tag
+ORDER BY count DESC, t.tag
+`
+
+type ListTagsRow struct {
+	Tag   string `json:"tag"`
+	Count int32  `json:"count"`
+}
+
+func (q *Queries) ListTags(ctx context.Context) ([]ListTagsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listTags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListTagsRow
+	for rows.Next() {
+		var i ListTagsRow
+		if err := rows.Scan(&i.Tag, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVoteAggregates = `-- name: ListVoteAggregates :many
+SELECT e.enemy_id, a.vote_count, a.vote_sum, a.median, a.trimmed_mean, coalesce(v.rating, 0)::real AS my_vote
+FROM vote_aggregates a
+JOIN enemies e ON e.id = a.enemy_id
+LEFT JOIN votes v ON v.enemy_id = a.enemy_id AND v.voter = $1::text
+WHERE e.enemy_id = ANY(($2::text)::text[])
+`
+
+type ListVoteAggregatesParams struct {
+	Voter    string `json:"voter"`
+	EnemyIds string `json:"enemy_ids"`
+}
+
+type ListVoteAggregatesRow struct {
+	EnemyID     string  `json:"enemy_id"`
+	VoteCount   int32   `json:"vote_count"`
+	VoteSum     float64 `json:"vote_sum"`
+	Median      float32 `json:"median"`
+	TrimmedMean float32 `json:"trimmed_mean"`
+	MyVote      float32 `json:"my_vote"`
+}
+
+// Aggregates of the enemies with votes, along with the vote of voter if any.
+func (q *Queries) ListVoteAggregates(ctx context.Context, arg ListVoteAggregatesParams) ([]ListVoteAggregatesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listVoteAggregates, arg.Voter, arg.EnemyIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListVoteAggregatesRow
+	for rows.Next() {
+		var i ListVoteAggregatesRow
+		if err := rows.Scan(
+			&i.EnemyID,
+			&i.VoteCount,
+			&i.VoteSum,
+			&i.Median,
+			&i.TrimmedMean,
+			&i.MyVote,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listVoteRatings = `-- name: ListVoteRatings :many
+SELECT rating FROM votes
+WHERE enemy_id = $1
+ORDER BY rating
+`
+
+func (q *Queries) ListVoteRatings(ctx context.Context, enemyID int32) ([]float32, error) {
+	rows, err := q.db.QueryContext(ctx, listVoteRatings, enemyID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []float32
+	for rows.Next() {
+		var rating float32
+		if err := rows.Scan(&rating); err != nil {
+			return nil, err
+		}
+		items = append(items, rating)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const notifyChange = `-- name: NotifyChange :exec
+SELECT pg_notify($1::text, $2::text)
+`
+
+type NotifyChangeParams struct {
+	Channel string `json:"channel"`
+	Payload string `json:"payload"`
+}
+
+func (q *Queries) NotifyChange(ctx context.Context, arg NotifyChangeParams) error {
+	_, err := q.db.ExecContext(ctx, notifyChange, arg.Channel, arg.Payload)
+	return err
+}
+
+const purgeEnemy = `-- name: PurgeEnemy :one
+DELETE FROM enemies
+WHERE enemy_id = $1
+RETURNING id, enemy_id, full_name, email, rating, last_updated, deleted_at, version
+`
+
+func (q *Queries) PurgeEnemy(ctx context.Context, enemyID string) (Enemy, error) {
+	row := q.db.QueryRowContext(ctx, purgeEnemy, enemyID)
+	var i Enemy
+	err := row.Scan(
+		&i.ID,
+		&i.EnemyID,
+		&i.FullName,
+		&i.Email,
+		&i.Rating,
+		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const removeEnemyTags = `-- name: RemoveEnemyTags :exec
+DELETE FROM enemy_tags
+WHERE enemy_id = $1::integer
+AND tag = ANY(($2::text)::text[])
+`
+
+type RemoveEnemyTagsParams struct {
+	ID   int32  `json:"id"`
+	Tags string `json:"tags"`
+}
+
+func (q *Queries) RemoveEnemyTags(ctx context.Context, arg RemoveEnemyTagsParams) error {
+	_, err := q.db.ExecContext(ctx, removeEnemyTags, arg.ID, arg.Tags)
+	return err
+}
+
+const setConfrontationRatings = `-- name: SetConfrontationRatings :exec
+UPDATE confrontations
+SET rating_before = $1::real,
+    rating_after = $2::real
+WHERE id = $3::integer
+`
+
+type SetConfrontationRatingsParams struct {
+	RatingBefore float32 `json:"rating_before"`
+	RatingAfter  float32 `json:"rating_after"`
+	ID           int32   `json:"id"`
+}
+
+func (q *Queries) SetConfrontationRatings(ctx context.Context, arg SetConfrontationRatingsParams) error {
+	_, err := q.db.ExecContext(ctx, setConfrontationRatings, arg.RatingBefore, arg.RatingAfter, arg.ID)
+	return err
+}
+
+const setIdempotencyKeyResponse = `-- name: SetIdempotencyKeyResponse :exec
+UPDATE idempotency_keys
+SET response = $2
+WHERE idempotency_key = $1
+`
+
+type SetIdempotencyKeyResponseParams struct {
+	IdempotencyKey string `json:"idempotency_key"`
+	Response       []byte `json:"response"`
+}
+
+func (q *Queries) SetIdempotencyKeyResponse(ctx context.Context, arg SetIdempotencyKeyResponseParams) error {
+	_, err := q.db.ExecContext(ctx, setIdempotencyKeyResponse, arg.IdempotencyKey, arg.Response)
+	return err
+}
+
+const setRatingBase = `-- name: SetRatingBase :exec
+INSERT INTO rating_bases (enemy_id, rating)
+VALUES ($1::integer, $2::real)
+ON CONFLICT (enemy_id) DO NOTHING
+`
+
+type SetRatingBaseParams struct {
+	EnemyID int32   `json:"enemy_id"`
+	Rating  float32 `json:"rating"`
+}
+
+// Keeps the first rating set for an enemy.
+func (q *Queries) SetRatingBase(ctx context.Context, arg SetRatingBaseParams) error {
+	_, err := q.db.ExecContext(ctx, setRatingBase, arg.EnemyID, arg.Rating)
+	return err
+}
+
+const touchEnemy = `-- name: TouchEnemy :one
+UPDATE enemies
+SET last_updated = $1::timestamp,
+    version = version + 1
+WHERE enemy_id = $2::text
+AND deleted_at IS NULL
+RETURNING id, enemy_id, full_name, email, rating, last_updated, deleted_at, version
+`
+
+type TouchEnemyParams struct {
+	LastUpdated time.Time `json:"last_updated"`
+	EnemyID     string    `json:"enemy_id"`
+}
+
+func (q *Queries) TouchEnemy(ctx context.Context, arg TouchEnemyParams) (Enemy, error) {
+	row := q.db.QueryRowContext(ctx, touchEnemy, arg.LastUpdated, arg.EnemyID)
+	var i Enemy
+	err := row.Scan(
+		&i.ID,
+		&i.EnemyID,
+		&i.FullName,
+		&i.Email,
+		&i.Rating,
+		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const undeleteEnemy = `-- name: UndeleteEnemy :one
+UPDATE enemies
+SET
+    deleted_at = NULL,
+    version = version + 1
+WHERE enemy_id = $1
+AND deleted_at IS NOT NULL
+RETURNING id, enemy_id, full_name, email, rating, last_updated, deleted_at, version
+`
+
+func (q *Queries) UndeleteEnemy(ctx context.Context, enemyID string) (Enemy, error) {
+	row := q.db.QueryRowContext(ctx, undeleteEnemy, enemyID)
+	var i Enemy
+	err := row.Scan(
+		&i.ID,
+		&i.EnemyID,
+		&i.FullName,
+		&i.Email,
+		&i.Rating,
+		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const unlinkEnemies = `-- name: UnlinkEnemies :one
+DELETE FROM relationships r
+USING enemies f, enemies t
+WHERE f.id = r.from_enemy_id
+AND t.id = r.to_enemy_id
+AND f.enemy_id = $1::text
+AND t.enemy_id = $2::text
+AND r.kind = $3::integer
+RETURNING r.id, r.from_enemy_id, r.to_enemy_id, r.kind, r.created_at
+`
+
+type UnlinkEnemiesParams struct {
+	FromEnemyID string `json:"from_enemy_id"`
+	ToEnemyID   string `json:"to_enemy_id"`
+	Kind        int32  `json:"kind"`
+}
+
+func (q *Queries) UnlinkEnemies(ctx context.Context, arg UnlinkEnemiesParams) (Relationship, error) {
+	row := q.db.QueryRowContext(ctx, unlinkEnemies, arg.FromEnemyID, arg.ToEnemyID, arg.Kind)
+	var i Relationship
+	err := row.Scan(
+		&i.ID,
+		&i.FromEnemyID,
+		&i.ToEnemyID,
+		&i.Kind,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const updateEnemy = `-- name: UpdateEnemy :one
+UPDATE enemies
+SET
+    full_name = CASE WHEN $1::boolean THEN $2::text ELSE full_name END,
+    email = CASE WHEN $3::boolean THEN $4::text ELSE email END,
+    rating = CASE WHEN $5::boolean THEN $6::real ELSE rating END,
+    last_updated = $7::timestamp,
+    version = version + 1
+WHERE enemy_id = $8::text
+AND deleted_at IS NULL
+RETURNING id, enemy_id, full_name, email, rating, last_updated, deleted_at, version
+`
+
+type UpdateEnemyParams struct {
+	SetFullName bool      `json:"set_full_name"`
+	FullName    string    `json:"full_name"`
+	SetEmail    bool      `json:"set_email"`
+	Email       string    `json:"email"`
+	SetRating   bool      `json:"set_rating"`
+	Rating      float32   `json:"rating"`
+	LastUpdated time.Time `json:"last_updated"`
+	EnemyID     string    `json:"enemy_id"`
+}
+
+func (q *Queries) UpdateEnemy(ctx context.Context, arg UpdateEnemyParams) (Enemy, error) {
+	row := q.db.QueryRowContext(ctx, updateEnemy,
+		arg.SetFullName,
+		arg.FullName,
+		arg.SetEmail,
+		arg.Email,
+		arg.SetRating,
+		arg.Rating,
+		arg.LastUpdated,
+		arg.EnemyID,
+	)
+	var i Enemy
+	err := row.Scan(
+		&i.ID,
+		&i.EnemyID,
+		&i.FullName,
+		&i.Email,
+		&i.Rating,
+		&i.LastUpdated,
+		&i.DeletedAt,
+		&i.Version,
+	)
+	return i, err
+}
+
+const updateVoteAggregate = `-- name: UpdateVoteAggregate :exec
+INSERT INTO vote_aggregates (enemy_id, vote_count, vote_sum, median, trimmed_mean)
+VALUES ($1::integer, $2::integer, $3::double precision, $4::real, $5::real)
+ON CONFLICT (enemy_id) DO UPDATE
+SET vote_count = vote_aggregates.vote_count + EXCLUDED.vote_count,
+    vote_sum = vote_aggregates.vote_sum + EXCLUDED.vote_sum,
+    median = EXCLUDED.median,
+    trimmed_mean = EXCLUDED.trimmed_mean
+`
+
+type UpdateVoteAggregateParams struct {
+	EnemyID     int32   `json:"enemy_id"`
+	AddedVotes  int32   `json:"added_votes"`
+	AddedSum    float64 `json:"added_sum"`
+	Median      float32 `json:"median"`
+	TrimmedMean float32 `json:"trimmed_mean"`
+}
+
+// Adds to the count and sum of the votes of an enemy, and sets the median and
+// trimmed mean, which need every vote.
+func (q *Queries) UpdateVoteAggregate(ctx context.Context, arg UpdateVoteAggregateParams) error {
+	_, err := q.db.ExecContext(ctx, updateVoteAggregate,
+		arg.EnemyID,
+		arg.AddedVotes,
+		arg.AddedSum,
+		arg.Median,
+		arg.TrimmedMean,
+	)
+	return err
+}
+
+const upsertStagedEnemies = `-- name: UpsertStagedEnemies :many
+INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated)
+SELECT DISTINCT ON (lower(email)) enemy_id, full_name, email, rating, $1::timestamp
+FROM enemy_staging
+WHERE load_id = $2::text
+ORDER BY lower(email), line DESC
+ON CONFLICT (lower(email)) WHERE deleted_at IS NULL DO UPDATE
+SET full_name = EXCLUDED.full_name,
+    email = EXCLUDED.email,
+    rating = EXCLUDED.rating,
+    last_updated = EXCLUDED.last_updated,
+    version = enemies.version + 1
+RETURNING id, enemy_id, full_name, email, rating, last_updated, deleted_at, version
+`
+
+type UpsertStagedEnemiesParams struct {
+	LastUpdated time.Time `json:"last_updated"`
+	LoadID      string    `json:"load_id"`
+}
+
+// Rows are upserted by email. If the same email is staged more than once, the
+// last line wins.
+func (q *Queries) UpsertStagedEnemies(ctx context.Context, arg UpsertStagedEnemiesParams) ([]Enemy, error) {
+	rows, err := q.db.QueryContext(ctx, upsertStagedEnemies, arg.LastUpdated, arg.LoadID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Enemy
+	for rows.Next() {
+		var i Enemy
+		if err := rows.Scan(
+			&i.ID,
+			&i.EnemyID,
+			&i.FullName,
+			&i.Email,
+			&i.Rating,
+			&i.LastUpdated,
+			&i.DeletedAt,
+			&i.Version,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
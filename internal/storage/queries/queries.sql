@@ -5,17 +5,407 @@ RETURNING *;
 
 -- name: GetEnemy :one
 SELECT * FROM enemies
-WHERE enemy_id = $1;
+WHERE enemy_id = @enemy_id::text
+AND (deleted_at IS NULL OR @show_deleted::boolean);
+
+-- name: GetEnemyForUpdate :one
+SELECT * FROM enemies
+WHERE enemy_id = $1
+FOR UPDATE;
 
 -- name: UpdateEnemy :one
 UPDATE enemies
 SET
-    full_name = COALESCE(NULLIF(@full_name::text, ''), full_name),
-    email = COALESCE(NULLIF(@email::text, ''), email),
-    rating = COALESCE(NULLIF(@rating::real, 0.0), rating),
-    last_updated = @last_updated::timestamp
+    full_name = CASE WHEN @set_full_name::boolean THEN @full_name::text ELSE full_name END,
+    email = CASE WHEN @set_email::boolean THEN @email::text ELSE email END,
+    rating = CASE WHEN @set_rating::boolean THEN @rating::real ELSE rating END,
+    last_updated = @last_updated::timestamp,
+    version = version + 1
+WHERE enemy_id = @enemy_id::text
+AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeleteEnemy :one
+UPDATE enemies
+SET
+    deleted_at = @deleted_at::timestamp,
+    version = version + 1
 WHERE enemy_id = @enemy_id::text
+AND deleted_at IS NULL
+RETURNING *;
+
+-- name: UndeleteEnemy :one
+UPDATE enemies
+SET
+    deleted_at = NULL,
+    version = version + 1
+WHERE enemy_id = $1
+AND deleted_at IS NOT NULL
+RETURNING *;
+
+-- name: PurgeEnemy :one
+DELETE FROM enemies
+WHERE enemy_id = $1
+RETURNING *;
+
+-- name: GetEnemyByEmail :one
+SELECT * FROM enemies
+WHERE lower(email) = lower(@email::text)
+AND deleted_at IS NULL;
+
+-- name: DeleteExpiredIdempotencyKeys :exec
+DELETE FROM idempotency_keys
+WHERE expires_at <= @now::timestamp;
+
+-- name: ClaimIdempotencyKey :execrows
+INSERT INTO idempotency_keys (idempotency_key, request_hash, created_at, expires_at)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (idempotency_key) DO NOTHING;
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE idempotency_key = $1;
+
+-- name: SetIdempotencyKeyResponse :exec
+UPDATE idempotency_keys
+SET response = $2
+WHERE idempotency_key = $1;
+
+-- name: AddRatingHistory :exec
+INSERT INTO enemy_rating_history (enemy_id, rating, changed_at)
+VALUES ($1, $2, $3);
+
+-- name: ListRatingHistory :many
+SELECT h.* FROM enemy_rating_history h
+JOIN enemies e ON e.id = h.enemy_id
+WHERE e.enemy_id = @enemy_id::text
+AND h.changed_at >= @start_time::timestamp
+AND h.changed_at < @end_time::timestamp
+AND (h.changed_at, h.id) > (@after_changed_at::timestamp, @after_id::integer)
+ORDER BY h.changed_at, h.id
+LIMIT @row_limit::integer;
+
+-- name: AddEnemyVersion :exec
+INSERT INTO enemy_versions (enemy_id, version, full_name, email, rating, last_updated, deleted_at, valid_from)
+SELECT id, version, full_name, email, rating, last_updated, deleted_at, @valid_from::timestamp
+FROM enemies
+WHERE id = @id::integer;
+
+-- name: GetEnemyAsOf :one
+SELECT e.id, e.enemy_id, v.full_name, v.email, v.rating, v.last_updated, v.deleted_at, v.version
+FROM enemy_versions v
+JOIN enemies e ON e.id = v.enemy_id
+WHERE e.enemy_id = @enemy_id::text
+AND v.valid_from <= @read_time::timestamp
+ORDER BY v.valid_from DESC, v.id DESC
+LIMIT 1;
+
+-- name: GetEnemyVersion :one
+SELECT e.id, e.enemy_id, v.full_name, v.email, v.rating, v.last_updated, v.deleted_at, v.version
+FROM enemy_versions v
+JOIN enemies e ON e.id = v.enemy_id
+WHERE e.enemy_id = @enemy_id::text
+AND v.version = @version::integer
+ORDER BY v.id DESC
+LIMIT 1;
+
+-- name: AddAuditEvent :exec
+INSERT INTO audit_log (enemy_id, actor, method, peer, before, after, created_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: ListAuditEvents :many
+SELECT * FROM audit_log
+WHERE (@enemy_id::text = '' OR enemy_id = @enemy_id::text)
+AND (@actor::text = '' OR actor = @actor::text)
+AND created_at >= @start_time::timestamp
+AND created_at < @end_time::timestamp
+AND id < @before_id::integer
+ORDER BY id DESC
+LIMIT @row_limit::integer;
+
+-- name: NotifyChange :exec
+SELECT pg_notify(@channel::text, @payload::text);
+
+-- name: BatchGetEnemies :many
+SELECT * FROM enemies
+WHERE enemy_id = ANY((@enemy_ids::text)::text[])
+AND (deleted_at IS NULL OR @show_deleted::boolean);
+
+-- name: GetStagedEnemiesForUpdate :many
+SELECT * FROM enemies
+WHERE lower(email) IN (SELECT lower(email) FROM enemy_staging WHERE load_id = $1)
+AND deleted_at IS NULL
+FOR UPDATE;
+
+-- name: UpsertStagedEnemies :many
+-- Rows are upserted by email. If the same email is staged more than once, the
+-- last line wins.
+INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated)
+SELECT DISTINCT ON (lower(email)) enemy_id, full_name, email, rating, @last_updated::timestamp
+FROM enemy_staging
+WHERE load_id = @load_id::text
+ORDER BY lower(email), line DESC
+ON CONFLICT (lower(email)) WHERE deleted_at IS NULL DO UPDATE
+SET full_name = EXCLUDED.full_name,
+    email = EXCLUDED.email,
+    rating = EXCLUDED.rating,
+    last_updated = EXCLUDED.last_updated,
+    version = enemies.version + 1
 RETURNING *;
 
--- name: ListEnemies :many
-SELECT * FROM enemies;
+-- name: DeleteStagedEnemies :exec
+DELETE FROM enemy_staging
+WHERE load_id = $1;
+
+-- name: ListEnemyTags :many
+SELECT e.enemy_id, t.tag FROM enemy_tags t
+JOIN enemies e ON e.id = t.enemy_id
+WHERE e.enemy_id = ANY((@enemy_ids::text)::text[])
+ORDER BY e.enemy_id, t.tag;
+
+-- name: AddEnemyTags :exec
+INSERT INTO enemy_tags (enemy_id, tag)
+SELECT @id::integer, unnest((@tags::text)::text[])
+ON CONFLICT DO NOTHING;
+
+-- name: RemoveEnemyTags :exec
+DELETE FROM enemy_tags
+WHERE enemy_id = @id::integer
+AND tag = ANY((@tags::text)::text[]);
+
+-- name: ClearEnemyTags :exec
+DELETE FROM enemy_tags
+WHERE enemy_id = $1;
+
+-- name: TouchEnemy :one
+UPDATE enemies
+SET last_updated = @last_updated::timestamp,
+    version = version + 1
+WHERE enemy_id = @enemy_id::text
+AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListTags :many
+SELECT t.tag, count(*)::integer AS count
+FROM enemy_tags t
+JOIN enemies e ON e.id = t.enemy_id
+WHERE e.deleted_at IS NULL
+GROUP BY t.tag
+ORDER BY count DESC, t.tag;
+
+-- name: AddGrievance :one
+INSERT INTO grievances (grievance_id, enemy_id, text, severity, occurred_at, created_by, created_at)
+SELECT @grievance_id::text, id, @text::text, @severity::integer, @occurred_at::timestamp, @created_by::text, @created_at::timestamp
+FROM enemies
+WHERE enemy_id = @enemy_id::text
+AND deleted_at IS NULL
+RETURNING *;
+
+-- name: ListGrievances :many
+SELECT g.* FROM grievances g
+JOIN enemies e ON e.id = g.enemy_id
+WHERE e.enemy_id = @enemy_id::text
+AND (g.occurred_at, g.id) < (@before_occurred_at::timestamp, @before_id::integer)
+ORDER BY g.occurred_at DESC, g.id DESC
+LIMIT @row_limit::integer;
+
+-- name: DeleteGrievance :one
+DELETE FROM grievances g
+USING enemies e
+WHERE e.id = g.enemy_id
+AND e.enemy_id = @enemy_id::text
+AND g.grievance_id = @grievance_id::text
+RETURNING g.*;
+
+-- name: CountGrievances :one
+SELECT count(*)::integer FROM grievances g
+JOIN enemies e ON e.id = g.enemy_id
+WHERE e.enemy_id = @enemy_id::text;
+
+-- name: LinkEnemies :one
+INSERT INTO relationships (from_enemy_id, to_enemy_id, kind, created_at)
+VALUES (@from_id::integer, @to_id::integer, @kind::integer, @created_at::timestamp)
+RETURNING *;
+
+-- name: UnlinkEnemies :one
+DELETE FROM relationships r
+USING enemies f, enemies t
+WHERE f.id = r.from_enemy_id
+AND t.id = r.to_enemy_id
+AND f.enemy_id = @from_enemy_id::text
+AND t.enemy_id = @to_enemy_id::text
+AND r.kind = @kind::integer
+RETURNING r.*;
+
+-- name: GetEnemyNetwork :many
+-- Walks relationships in both directions from an enemy, skipping deleted
+-- enemies, and returns every enemy reached along with its distance from the
+-- start. An empty kinds array follows relationships of every kind.
+WITH RECURSIVE network (id, depth) AS (
+    SELECT e.id, 0 FROM enemies e
+    WHERE e.enemy_id = @enemy_id::text
+    AND e.deleted_at IS NULL
+  UNION
+    SELECT e.id, n.depth + 1
+    FROM network n
+    JOIN relationships r ON n.id IN (r.from_enemy_id, r.to_enemy_id)
+    JOIN enemies e ON e.id = CASE WHEN r.from_enemy_id = n.id THEN r.to_enemy_id ELSE r.from_enemy_id END
+    WHERE n.depth < @max_depth::integer
+    AND e.deleted_at IS NULL
+    AND (cardinality((@kinds::text)::integer[]) = 0 OR r.kind = ANY((@kinds::text)::integer[]))
+)
+SELECT e.id, e.enemy_id, e.full_name, e.email, e.rating, e.last_updated, e.deleted_at, e.version, min(n.depth)::integer AS depth
+FROM network n
+JOIN enemies e ON e.id = n.id
+GROUP BY e.id
+ORDER BY depth, e.id;
+
+-- name: ListRelationshipsBetween :many
+-- Relationships where both enemies are among ids.
+SELECT r.kind, r.created_at, f.enemy_id AS from_enemy_id, t.enemy_id AS to_enemy_id
+FROM relationships r
+JOIN enemies f ON f.id = r.from_enemy_id
+JOIN enemies t ON t.id = r.to_enemy_id
+WHERE r.from_enemy_id = ANY((@ids::text)::integer[])
+AND r.to_enemy_id = ANY((@ids::text)::integer[])
+AND (cardinality((@kinds::text)::integer[]) = 0 OR r.kind = ANY((@kinds::text)::integer[]))
+ORDER BY r.id;
+
+-- name: GetLeaderboard :many
+-- Ranks the enemies that aren't deleted by rating, highest first, optionally
+-- only those with a tag or last updated within a time window. Ties are broken
+-- by who got their rating first, and then by id, like GetEnemyRank.
+SELECT e.id, e.enemy_id, e.full_name, e.email, e.rating, e.last_updated, e.deleted_at, e.version,
+    (dense_rank() OVER (ORDER BY e.rating DESC, r.rated_at, e.id))::integer AS rank,
+    (100 * percent_rank() OVER (ORDER BY e.rating))::double precision AS percentile
+FROM enemies e
+JOIN enemy_rated_at r ON r.enemy_id = e.id
+WHERE e.deleted_at IS NULL
+AND (@tag::text = '' OR EXISTS (SELECT 1 FROM enemy_tags t WHERE t.enemy_id = e.id AND t.tag = @tag::text))
+AND e.last_updated >= @start_time::timestamp
+AND e.last_updated < @end_time::timestamp
+ORDER BY e.rating DESC, r.rated_at, e.id
+LIMIT @row_limit::integer;
+
+-- name: GetEnemyRank :one
+-- The rank of an enemy that isn't deleted, among all enemies that aren't,
+-- in the order of GetLeaderboard.
+SELECT ranked.rank FROM (
+    SELECT e.enemy_id, (dense_rank() OVER (ORDER BY e.rating DESC, r.rated_at, e.id))::integer AS rank
+    FROM enemies e
+    JOIN enemy_rated_at r ON r.enemy_id = e.id
+    WHERE e.deleted_at IS NULL
+) ranked
+WHERE ranked.enemy_id = @enemy_id::text;
+
+-- name: GetRatingStats :one
+SELECT count(*)::integer AS count,
+    coalesce(avg(rating), 0)::double precision AS mean,
+    coalesce(percentile_cont(0.5) WITHIN GROUP (ORDER BY rating), 0)::double precision AS median,
+    coalesce(percentile_cont(0.9) WITHIN GROUP (ORDER BY rating), 0)::double precision AS p90
+FROM enemies
+WHERE deleted_at IS NULL;
+
+-- name: GetRatingHistogram :many
+-- Bucket 0 is below the first boundary, and bucket i is at or above the i-th
+-- boundary but below the next one. Empty buckets are left out.
+SELECT width_bucket(rating, (@boundaries::text)::real[])::integer AS bucket, count(*)::integer AS count
+FROM enemies
+WHERE deleted_at IS NULL
+GROUP BY 1
+ORDER BY 1;
+
+-- name: GetDailyChanges :many
+-- Counts the enemies added and updated each day of the time window, in UTC.
+-- Versions are numbered from 1, so any later version is an update, unless it
+-- is a delete.
+SELECT d.day::timestamp AS day,
+    count(v.id) FILTER (WHERE v.version = 1)::integer AS added,
+    count(v.id) FILTER (WHERE v.version > 1 AND v.deleted_at IS NULL)::integer AS updated
+FROM (
+    SELECT generate_series(date_trunc('day', @start_time::timestamp), @end_time::timestamp, interval '1 day') AS day
+) d
+LEFT JOIN enemy_versions v ON v.valid_from >= d.day
+    AND v.valid_from < d.day + interval '1 day'
+    AND v.valid_from >= @start_time::timestamp
+    AND v.valid_from < @end_time::timestamp
+WHERE d.day < @end_time::timestamp
+GROUP BY d.day
+ORDER BY d.day;
+
+-- name: AddConfrontation :one
+INSERT INTO confrontations (confrontation_id, enemy_id, outcome, occurred_at, notes, rating_before, rating_after, created_at)
+VALUES (@confrontation_id::text, @enemy_id::integer, @outcome::integer, @occurred_at::timestamp, @notes::text, @rating_before::real, @rating_after::real, @created_at::timestamp)
+RETURNING *;
+
+-- name: SetRatingBase :exec
+-- Keeps the first rating set for an enemy.
+INSERT INTO rating_bases (enemy_id, rating)
+VALUES (@enemy_id::integer, @rating::real)
+ON CONFLICT (enemy_id) DO NOTHING;
+
+-- name: HasConfrontationsAfter :one
+SELECT EXISTS (
+    SELECT 1 FROM confrontations
+    WHERE enemy_id = @enemy_id::integer
+    AND occurred_at > @occurred_at::timestamp
+);
+
+-- name: ListConfrontationsForUpdate :many
+-- The confrontations of every enemy, or only of enemy_id, in the order they
+-- are replayed in along with the rating the replay starts from, locking them
+-- and their enemies.
+SELECT c.*, b.rating AS base_rating FROM confrontations c
+JOIN enemies e ON e.id = c.enemy_id
+JOIN rating_bases b ON b.enemy_id = c.enemy_id
+WHERE @all_enemies::boolean OR c.enemy_id = @enemy_id::integer
+ORDER BY c.enemy_id, c.occurred_at, c.created_at, c.id
+FOR UPDATE;
+
+-- name: SetConfrontationRatings :exec
+UPDATE confrontations
+SET rating_before = @rating_before::real,
+    rating_after = @rating_after::real
+WHERE id = @id::integer;
+
+-- name: GetEnemyByIDForUpdate :one
+SELECT * FROM enemies
+WHERE id = $1
+FOR UPDATE;
+
+-- name: GetVoteForUpdate :one
+SELECT rating FROM votes
+WHERE enemy_id = @enemy_id::integer
+AND voter = @voter::text
+FOR UPDATE;
+
+-- name: CastVote :exec
+INSERT INTO votes (enemy_id, voter, rating, cast_at)
+VALUES (@enemy_id::integer, @voter::text, @rating::real, @cast_at::timestamp)
+ON CONFLICT (enemy_id, voter) DO UPDATE
+SET rating = EXCLUDED.rating,
+    cast_at = EXCLUDED.cast_at;
+
+-- name: UpdateVoteAggregate :exec
+-- Adds to the count and sum of the votes of an enemy, and sets the median and
+-- trimmed mean, which need every vote.
+INSERT INTO vote_aggregates (enemy_id, vote_count, vote_sum, median, trimmed_mean)
+VALUES (@enemy_id::integer, @added_votes::integer, @added_sum::double precision, @median::real, @trimmed_mean::real)
+ON CONFLICT (enemy_id) DO UPDATE
+SET vote_count = vote_aggregates.vote_count + EXCLUDED.vote_count,
+    vote_sum = vote_aggregates.vote_sum + EXCLUDED.vote_sum,
+    median = EXCLUDED.median,
+    trimmed_mean = EXCLUDED.trimmed_mean;
+
+-- name: ListVoteRatings :many
+SELECT rating FROM votes
+WHERE enemy_id = $1
+ORDER BY rating;
+
+-- name: ListVoteAggregates :many
+-- Aggregates of the enemies with votes, along with the vote of voter if any.
+SELECT e.enemy_id, a.vote_count, a.vote_sum, a.median, a.trimmed_mean, coalesce(v.rating, 0)::real AS my_vote
+FROM vote_aggregates a
+JOIN enemies e ON e.id = a.enemy_id
+LEFT JOIN votes v ON v.enemy_id = a.enemy_id AND v.voter = @voter::text
+WHERE e.enemy_id = ANY((@enemy_ids::text)::text[]);
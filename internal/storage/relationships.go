@@ -0,0 +1,160 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/jackc/pgx"
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Used by GetEnemyNetwork when no depth is asked for.
+const defaultNetworkDepth = 1
+
+// LinkEnemies adds a relationship between two enemies that aren't deleted.
+func (e *EnemyStore) LinkEnemies(ctx context.Context, req *enemy.LinkEnemiesRequest) (*enemy.LinkEnemiesResponse, error) {
+	var rel Relationship
+	err := e.inTx(ctx, func(q *Queries) error {
+		from, err := getLinkable(ctx, q, req.GetFromEnemyId())
+		if err != nil {
+			return err
+		}
+		to, err := getLinkable(ctx, q, req.GetToEnemyId())
+		if err != nil {
+			return err
+		}
+		rel, err = q.LinkEnemies(ctx, LinkEnemiesParams{
+			FromID:    from.ID,
+			ToID:      to.ID,
+			Kind:      int32(req.GetKind()),
+			CreatedAt: now(),
+		})
+		if err != nil {
+			return err
+		}
+		return e.auditLink(ctx, q, "LinkEnemies", req.GetFromEnemyId(), req.GetToEnemyId())
+	})
+	var pgErr pgx.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == pgUniqueViolation {
+		return nil, errorf(ErrConflict, "enemy %s is already linked to enemy %s as %s", req.GetFromEnemyId(), req.GetToEnemyId(), req.GetKind())
+	}
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &enemy.LinkEnemiesResponse{
+		Relationship: relationshipToProto(rel, req.GetFromEnemyId(), req.GetToEnemyId()),
+	}, nil
+}
+
+func getLinkable(ctx context.Context, q *Queries, enemyID string) (Enemy, error) {
+	enmy, err := q.GetEnemy(ctx, GetEnemyParams{EnemyID: enemyID})
+	if errors.Is(err, sql.ErrNoRows) {
+		return Enemy{}, errorf(ErrNotFound, "enemy %s not found", enemyID)
+	}
+	if err != nil {
+		return Enemy{}, dbError(err)
+	}
+	return enmy, nil
+}
+
+func (e *EnemyStore) UnlinkEnemies(ctx context.Context, req *enemy.UnlinkEnemiesRequest) (*enemy.UnlinkEnemiesResponse, error) {
+	var rel Relationship
+	err := e.inTx(ctx, func(q *Queries) error {
+		var err error
+		rel, err = q.UnlinkEnemies(ctx, UnlinkEnemiesParams{
+			FromEnemyID: req.GetFromEnemyId(),
+			ToEnemyID:   req.GetToEnemyId(),
+			Kind:        int32(req.GetKind()),
+		})
+		if errors.Is(err, sql.ErrNoRows) {
+			return errorf(ErrNotFound, "enemy %s isn't linked to enemy %s as %s", req.GetFromEnemyId(), req.GetToEnemyId(), req.GetKind())
+		}
+		if err != nil {
+			return err
+		}
+		return e.auditLink(ctx, q, "UnlinkEnemies", req.GetFromEnemyId(), req.GetToEnemyId())
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &enemy.UnlinkEnemiesResponse{
+		Relationship: relationshipToProto(rel, req.GetFromEnemyId(), req.GetToEnemyId()),
+	}, nil
+}
+
+// auditLink records a change to a relationship in the audit log of both
+// enemies.
+func (e *EnemyStore) auditLink(ctx context.Context, q *Queries, method, fromEnemyID, toEnemyID string) error {
+	if err := e.auditRelated(ctx, q, method, fromEnemyID); err != nil {
+		return err
+	}
+	return e.auditRelated(ctx, q, method, toEnemyID)
+}
+
+// GetEnemyNetwork only returns edges between the nodes it returns, even if
+// the graph changes between reading the two.
+func (e *EnemyStore) GetEnemyNetwork(ctx context.Context, req *enemy.GetEnemyNetworkRequest) (*enemy.GetEnemyNetworkResponse, error) {
+	depth := req.GetDepth()
+	if depth <= 0 {
+		depth = defaultNetworkDepth
+	}
+	kinds := []int32{}
+	for _, kind := range req.GetKinds() {
+		kinds = append(kinds, int32(kind))
+	}
+	nodes, err := e.queries.GetEnemyNetwork(ctx, GetEnemyNetworkParams{
+		EnemyID:  req.GetId(),
+		MaxDepth: depth,
+		Kinds:    intArray(kinds),
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	if len(nodes) == 0 {
+		return nil, errorf(ErrNotFound, "enemy %s not found", req.GetId())
+	}
+	res := &enemy.GetEnemyNetworkResponse{}
+	ids := make([]int32, len(nodes))
+	enmys := make([]*enemy.Enemy, len(nodes))
+	for i, node := range nodes {
+		ids[i] = node.ID
+		enmys[i] = toProto(Enemy{
+			ID:          node.ID,
+			EnemyID:     node.EnemyID,
+			FullName:    node.FullName,
+			Email:       node.Email,
+			Rating:      node.Rating,
+			LastUpdated: node.LastUpdated,
+			DeletedAt:   node.DeletedAt,
+			Version:     node.Version,
+		})
+		res.Nodes = append(res.Nodes, &enemy.NetworkNode{Enemy: enmys[i], Depth: node.Depth})
+	}
+	if err := e.loadDetails(ctx, e.queries, voter(ctx), enmys...); err != nil {
+		return nil, dbError(err)
+	}
+	edges, err := e.queries.ListRelationshipsBetween(ctx, ListRelationshipsBetweenParams{Ids: intArray(ids), Kinds: intArray(kinds)})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	for _, edge := range edges {
+		res.Edges = append(res.Edges, &enemy.Relationship{
+			FromEnemyId: edge.FromEnemyID,
+			ToEnemyId:   edge.ToEnemyID,
+			Kind:        enemy.Relationship_Kind(edge.Kind),
+			CreatedAt:   timestamppb.New(edge.CreatedAt),
+		})
+	}
+	return res, nil
+}
+
+func relationshipToProto(rel Relationship, fromEnemyID, toEnemyID string) *enemy.Relationship {
+	return &enemy.Relationship{
+		FromEnemyId: fromEnemyID,
+		ToEnemyId:   toEnemyID,
+		Kind:        enemy.Relationship_Kind(rel.Kind),
+		CreatedAt:   timestamppb.New(rel.CreatedAt),
+	}
+}
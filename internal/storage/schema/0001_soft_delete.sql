@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE enemies ADD COLUMN deleted_at TIMESTAMP;
+
+-- +migrate Down
+ALTER TABLE enemies DROP COLUMN IF EXISTS deleted_at;
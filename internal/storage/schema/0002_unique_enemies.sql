@@ -0,0 +1,28 @@
+-- +migrate Up
+-- Rows sharing an enemy_id get the row id appended, except for the oldest one.
+UPDATE enemies e
+SET enemy_id = e.enemy_id || '-' || e.id
+WHERE EXISTS (
+    SELECT 1 FROM enemies o
+    WHERE o.enemy_id = e.enemy_id
+    AND o.id < e.id
+);
+
+-- Of active enemies sharing an email, ignoring case, the most recently updated
+-- one is kept and the rest are soft deleted.
+UPDATE enemies e
+SET deleted_at = now()
+WHERE e.deleted_at IS NULL
+AND EXISTS (
+    SELECT 1 FROM enemies o
+    WHERE lower(o.email) = lower(e.email)
+    AND o.deleted_at IS NULL
+    AND (o.last_updated > e.last_updated OR (o.last_updated = e.last_updated AND o.id > e.id))
+);
+
+CREATE UNIQUE INDEX enemies_enemy_id_key ON enemies (enemy_id);
+CREATE UNIQUE INDEX enemies_email_key ON enemies (lower(email)) WHERE deleted_at IS NULL;
+
+-- +migrate Down
+DROP INDEX IF EXISTS enemies_email_key;
+DROP INDEX IF EXISTS enemies_enemy_id_key;
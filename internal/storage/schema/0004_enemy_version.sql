@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE enemies ADD COLUMN version INTEGER NOT NULL DEFAULT 1;
+
+-- +migrate Down
+ALTER TABLE enemies DROP COLUMN IF EXISTS version;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE enemy_rating_history (
+    id          SERIAL PRIMARY KEY,
+    enemy_id    INTEGER NOT NULL REFERENCES enemies (id) ON DELETE CASCADE,
+    rating      REAL NOT NULL,
+    changed_at  TIMESTAMP NOT NULL
+);
+
+CREATE INDEX enemy_rating_history_enemy_id_changed_at_idx ON enemy_rating_history (enemy_id, changed_at, id);
+
+-- Start the history of existing enemies with their current rating.
+INSERT INTO enemy_rating_history (enemy_id, rating, changed_at)
+SELECT id, rating, last_updated FROM enemies;
+
+-- +migrate Down
+DROP TABLE IF EXISTS enemy_rating_history;
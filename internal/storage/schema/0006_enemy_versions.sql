@@ -0,0 +1,22 @@
+-- +migrate Up
+-- Every state an enemy has been in, for reading it as of some point in time.
+-- Rows are only ever appended.
+CREATE TABLE enemy_versions (
+    id              SERIAL PRIMARY KEY,
+    enemy_id        INTEGER NOT NULL REFERENCES enemies (id) ON DELETE CASCADE,
+    version         INTEGER NOT NULL,
+    full_name       TEXT NOT NULL,
+    email           TEXT NOT NULL,
+    rating          REAL NOT NULL,
+    last_updated    TIMESTAMP NOT NULL,
+    deleted_at      TIMESTAMP,
+    valid_from      TIMESTAMP NOT NULL
+);
+
+CREATE INDEX enemy_versions_enemy_id_valid_from_idx ON enemy_versions (enemy_id, valid_from, id);
+
+INSERT INTO enemy_versions (enemy_id, version, full_name, email, rating, last_updated, deleted_at, valid_from)
+SELECT id, version, full_name, email, rating, last_updated, deleted_at, last_updated FROM enemies;
+
+-- +migrate Down
+DROP TABLE IF EXISTS enemy_versions;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- Who changed what. The enemy id is the public one and has no foreign key, so
+-- the log outlives purged enemies.
+CREATE TABLE audit_log (
+    id          SERIAL PRIMARY KEY,
+    enemy_id    TEXT NOT NULL,
+    actor       TEXT NOT NULL,
+    method      TEXT NOT NULL,
+    peer        TEXT NOT NULL,
+    before      JSONB NOT NULL DEFAULT 'null',
+    after       JSONB NOT NULL DEFAULT 'null',
+    created_at  TIMESTAMP NOT NULL
+);
+
+CREATE INDEX audit_log_enemy_id_idx ON audit_log (enemy_id, id);
+CREATE INDEX audit_log_actor_idx ON audit_log (actor, id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS audit_log;
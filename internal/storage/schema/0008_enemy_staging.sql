@@ -0,0 +1,16 @@
+-- +migrate Up
+-- Rows of bulk loads are copied here before being upserted into enemies. Rows
+-- only live for the duration of a load, so there is no need to WAL log them.
+CREATE UNLOGGED TABLE enemy_staging (
+    load_id   TEXT NOT NULL,
+    line      INTEGER NOT NULL,
+    enemy_id  TEXT NOT NULL,
+    full_name TEXT NOT NULL,
+    email     TEXT NOT NULL,
+    rating    REAL NOT NULL
+);
+
+CREATE INDEX enemy_staging_load_id_idx ON enemy_staging (load_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS enemy_staging;
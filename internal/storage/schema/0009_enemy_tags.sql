@@ -0,0 +1,11 @@
+-- +migrate Up
+CREATE TABLE enemy_tags (
+    enemy_id INTEGER NOT NULL REFERENCES enemies (id) ON DELETE CASCADE,
+    tag      TEXT NOT NULL,
+    PRIMARY KEY (enemy_id, tag)
+);
+
+CREATE INDEX enemy_tags_tag_idx ON enemy_tags (tag);
+
+-- +migrate Down
+DROP TABLE IF EXISTS enemy_tags;
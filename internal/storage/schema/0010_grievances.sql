@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE grievances (
+    id           SERIAL PRIMARY KEY,
+    grievance_id TEXT NOT NULL UNIQUE,
+    enemy_id     INTEGER NOT NULL REFERENCES enemies (id) ON DELETE CASCADE,
+    text         TEXT NOT NULL,
+    severity     INTEGER NOT NULL,
+    occurred_at  TIMESTAMP NOT NULL,
+    created_by   TEXT NOT NULL,
+    created_at   TIMESTAMP NOT NULL
+);
+
+CREATE INDEX grievances_enemy_id_occurred_at_idx ON grievances (enemy_id, occurred_at DESC, id DESC);
+
+-- +migrate Down
+DROP TABLE IF EXISTS grievances;
@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE relationships (
+    id            SERIAL PRIMARY KEY,
+    from_enemy_id INTEGER NOT NULL REFERENCES enemies (id) ON DELETE CASCADE,
+    to_enemy_id   INTEGER NOT NULL REFERENCES enemies (id) ON DELETE CASCADE,
+    kind          INTEGER NOT NULL,
+    created_at    TIMESTAMP NOT NULL,
+    UNIQUE (from_enemy_id, to_enemy_id, kind),
+    CHECK (from_enemy_id <> to_enemy_id)
+);
+
+-- The unique constraint covers lookups by from_enemy_id.
+CREATE INDEX relationships_to_enemy_id_idx ON relationships (to_enemy_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS relationships;
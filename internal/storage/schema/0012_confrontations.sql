@@ -0,0 +1,26 @@
+-- +migrate Up
+-- Log of confrontations with enemies, which their ratings are computed from.
+CREATE TABLE confrontations (
+    id               SERIAL PRIMARY KEY,
+    confrontation_id TEXT NOT NULL UNIQUE,
+    enemy_id         INTEGER NOT NULL REFERENCES enemies (id) ON DELETE CASCADE,
+    outcome          INTEGER NOT NULL,
+    occurred_at      TIMESTAMP NOT NULL,
+    notes            TEXT NOT NULL,
+    rating_before    REAL NOT NULL,
+    rating_after     REAL NOT NULL,
+    created_at       TIMESTAMP NOT NULL
+);
+
+CREATE INDEX confrontations_enemy_id_occurred_at_idx ON confrontations (enemy_id, occurred_at, created_at, id);
+
+-- The rating of each enemy before its first recorded confrontation, which
+-- replays of its confrontations start from.
+CREATE TABLE rating_bases (
+    enemy_id INTEGER PRIMARY KEY REFERENCES enemies (id) ON DELETE CASCADE,
+    rating   REAL NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS rating_bases;
+DROP TABLE IF EXISTS confrontations;
@@ -0,0 +1,25 @@
+-- +migrate Up
+-- One vote per user and enemy, rating the enemy.
+CREATE TABLE votes (
+    enemy_id INTEGER NOT NULL REFERENCES enemies (id) ON DELETE CASCADE,
+    voter    TEXT NOT NULL,
+    rating   REAL NOT NULL,
+    cast_at  TIMESTAMP NOT NULL,
+    PRIMARY KEY (enemy_id, voter)
+);
+
+-- Aggregates of the votes of each enemy, updated whenever a vote is cast so
+-- reads don't have to go through every vote. The count and sum are kept up to
+-- date from the old and new vote. Every supported aggregation is kept, so
+-- changing the configured one takes effect immediately.
+CREATE TABLE vote_aggregates (
+    enemy_id     INTEGER PRIMARY KEY REFERENCES enemies (id) ON DELETE CASCADE,
+    vote_count   INTEGER NOT NULL,
+    vote_sum     DOUBLE PRECISION NOT NULL,
+    median       REAL NOT NULL,
+    trimmed_mean REAL NOT NULL
+);
+
+-- +migrate Down
+DROP TABLE IF EXISTS vote_aggregates;
+DROP TABLE IF EXISTS votes;
@@ -0,0 +1,17 @@
+-- +migrate Up
+-- When each enemy got its current rating, which ties on the leaderboard are
+-- broken by. Unlike last_updated, it only changes with the rating. Later
+-- history rows with the same rating don't count, as the rating didn't change.
+CREATE VIEW enemy_rated_at AS
+SELECT e.id AS enemy_id, coalesce(min(h.changed_at), e.last_updated)::timestamp AS rated_at
+FROM enemies e
+LEFT JOIN enemy_rating_history h ON h.enemy_id = e.id
+    AND h.id > coalesce((
+        SELECT max(p.id) FROM enemy_rating_history p
+        WHERE p.enemy_id = e.id
+        AND p.rating <> e.rating
+    ), 0)
+GROUP BY e.id, e.last_updated;
+
+-- +migrate Down
+DROP VIEW IF EXISTS enemy_rated_at;
@@ -0,0 +1,93 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Used by GetStats when no bucket boundaries are asked for.
+var defaultBucketBoundaries = []float32{2, 4, 6, 8}
+
+// Time window of the daily changes of GetStats.
+const (
+	defaultStatsWindow = 30 * 24 * time.Hour
+	maxStatsWindow     = 366 * 24 * time.Hour
+)
+
+// statsWindow returns the time window of the daily changes of req. The end
+// defaults to now, and the start to defaultStatsWindow before the end, so the
+// window is checked once they are applied.
+func statsWindow(req *enemy.GetStatsRequest) (time.Time, time.Time, error) {
+	endTime := now()
+	if req.GetEndTime() != nil {
+		endTime = req.GetEndTime().AsTime()
+	}
+	startTime := endTime.Add(-defaultStatsWindow)
+	if req.GetStartTime() != nil {
+		startTime = req.GetStartTime().AsTime()
+	}
+	switch {
+	case !startTime.Before(endTime):
+		return time.Time{}, time.Time{}, errorf(ErrInvalidArgument, "start time must be before end time")
+	case endTime.Sub(startTime) > maxStatsWindow:
+		return time.Time{}, time.Time{}, errorf(ErrInvalidArgument, "time window can't be longer than %d days", maxStatsWindow/(24*time.Hour))
+	}
+	return startTime, endTime, nil
+}
+
+// GetStats leaves all the number crunching to the database. The queries run in
+// one snapshot, so the count and the histogram agree.
+func (e *EnemyStore) GetStats(ctx context.Context, req *enemy.GetStatsRequest) (*enemy.GetStatsResponse, error) {
+	boundaries := req.GetBucketBoundaries()
+	if len(boundaries) == 0 {
+		boundaries = defaultBucketBoundaries
+	}
+	startTime, endTime, err := statsWindow(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats GetRatingStatsRow
+	var buckets []GetRatingHistogramRow
+	var days []GetDailyChangesRow
+	err = e.inSnapshot(ctx, func(q *Queries) error {
+		var err error
+		if stats, err = q.GetRatingStats(ctx); err != nil {
+			return err
+		}
+		if buckets, err = q.GetRatingHistogram(ctx, realArray(boundaries)); err != nil {
+			return err
+		}
+		days, err = q.GetDailyChanges(ctx, GetDailyChangesParams{
+			StartTime: startTime,
+			EndTime:   endTime,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+
+	res := &enemy.GetStatsResponse{
+		Count:            stats.Count,
+		MeanRating:       stats.Mean,
+		MedianRating:     stats.Median,
+		P90Rating:        stats.P90,
+		BucketBoundaries: boundaries,
+		Histogram:        make([]int32, len(boundaries)+1),
+	}
+	for _, bucket := range buckets {
+		res.Histogram[bucket.Bucket] = bucket.Count
+	}
+	for _, day := range days {
+		res.Daily = append(res.Daily, &enemy.DailyChanges{
+			Day:     timestamppb.New(day.Day),
+			Added:   day.Added,
+			Updated: day.Updated,
+		})
+	}
+	return res, nil
+}
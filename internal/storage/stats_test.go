@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestStatsWindow(t *testing.T) {
+	now = func() time.Time { return time.Date(2021, time.December, 31, 12, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name      string
+		give      *enemy.GetStatsRequest
+		wantStart time.Time
+		wantEnd   time.Time
+		wantErr   error
+	}{
+		{
+			name:      "Test defaults",
+			give:      &enemy.GetStatsRequest{},
+			wantStart: time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2021, time.December, 31, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Test start only",
+			give:      &enemy.GetStatsRequest{StartTime: timestamppb.New(time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC))},
+			wantStart: time.Date(2021, time.June, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2021, time.December, 31, 12, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "Test start in the future",
+			give:    &enemy.GetStatsRequest{StartTime: timestamppb.New(time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC))},
+			wantErr: errorf(ErrInvalidArgument, "start time must be before end time"),
+		},
+		{
+			name: "Test start after end",
+			give: &enemy.GetStatsRequest{
+				StartTime: timestamppb.New(time.Date(2021, time.December, 2, 0, 0, 0, 0, time.UTC)),
+				EndTime:   timestamppb.New(time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)),
+			},
+			wantErr: errorf(ErrInvalidArgument, "start time must be before end time"),
+		},
+		{
+			name:    "Test window too long",
+			give:    &enemy.GetStatsRequest{StartTime: timestamppb.New(time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC))},
+			wantErr: errorf(ErrInvalidArgument, "time window can't be longer than 366 days"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			start, end, err := statsWindow(test.give)
+			assert.Equal(t, test.wantErr, err)
+			assert.Equal(t, test.wantStart, start)
+			assert.Equal(t, test.wantEnd, end)
+		})
+	}
+}
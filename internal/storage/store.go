@@ -3,8 +3,15 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
 	"time"
 
+	"github.com/jackc/pgx"
+	"github.com/larwef/rpi-docker-test/internal/filter"
+	"github.com/larwef/rpi-docker-test/internal/orderby"
 	"github.com/larwef/rpi-docker-test/pkg/enemy"
 	"github.com/rs/xid"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -18,21 +25,75 @@ var id = func() string {
 	return xid.New().String()
 }
 
+// Used as the end of open ended time ranges.
+var maxTime = time.Date(9999, time.December, 31, 0, 0, 0, 0, time.UTC)
+
 type EnemyStore struct {
+	db      *sql.DB
 	queries *Queries
+	// How much a single confrontation can move a rating.
+	kFactor float64
+	// How votes are combined into the vote rating of an enemy.
+	voteAggregation VoteAggregation
+}
+
+type Option func(*EnemyStore)
+
+// WithKFactor sets the K-factor of the Elo ratings computed from
+// confrontations. The default is 0.5.
+func WithKFactor(k float64) Option {
+	return func(e *EnemyStore) {
+		e.kFactor = k
+	}
 }
 
-func NewEnemyStore(db *sql.DB) (*EnemyStore, error) {
+// ValidateRating is the rule for the rating of an enemy, however the enemy is
+// added, and for the ratings users vote for.
+func ValidateRating(rating float32) error {
+	switch {
+	case math.IsNaN(float64(rating)) || math.IsInf(float64(rating), 0):
+		return errorf(ErrInvalidArgument, "rating must be finite")
+	case rating <= 0:
+		return errorf(ErrInvalidArgument, "rating must be > 0")
+	}
+	return nil
+}
+
+func NewEnemyStore(db *sql.DB, opts ...Option) (*EnemyStore, error) {
 	if err := migrateUp(db); err != nil {
 		return nil, err
 	}
-	return &EnemyStore{
+	e := &EnemyStore{
+		db:      db,
 		queries: New(db),
-	}, nil
+		kFactor: defaultKFactor,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e, nil
 }
 
-func (e *EnemyStore) AddEnemy(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, error) {
-	enmy, err := e.queries.AddEnemy(ctx, AddEnemyParams{
+// AddEnemy also reports whether the response was replayed for an idempotency
+// key used before, in which case nothing was added.
+func (e *EnemyStore) AddEnemy(ctx context.Context, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, bool, error) {
+	if req.GetIdempotencyKey() != "" {
+		return e.addEnemyIdempotent(ctx, req)
+	}
+	var res *enemy.AddEnemyResponse
+	err := e.inTx(ctx, func(q *Queries) error {
+		var err error
+		res, err = e.addEnemy(ctx, q, req)
+		return err
+	})
+	if err != nil {
+		return nil, false, dbError(err)
+	}
+	return res, false, nil
+}
+
+func (e *EnemyStore) addEnemy(ctx context.Context, q *Queries, req *enemy.AddEnemyRequest) (*enemy.AddEnemyResponse, error) {
+	enmy, err := q.AddEnemy(ctx, AddEnemyParams{
 		EnemyID:     id(),
 		FullName:    req.GetName(),
 		Email:       req.GetEmail(),
@@ -40,73 +101,553 @@ func (e *EnemyStore) AddEnemy(ctx context.Context, req *enemy.AddEnemyRequest) (
 		LastUpdated: now(),
 	})
 	if err != nil {
+		return nil, e.conflictError(ctx, err, req.GetEmail())
+	}
+	if len(req.GetTags()) > 0 {
+		if err := q.AddEnemyTags(ctx, AddEnemyTagsParams{ID: enmy.ID, Tags: textArray(req.GetTags())}); err != nil {
+			return nil, err
+		}
+	}
+	if err := q.AddEnemyVersion(ctx, AddEnemyVersionParams{ID: enmy.ID, ValidFrom: enmy.LastUpdated}); err != nil {
+		return nil, err
+	}
+	if err := q.AddRatingHistory(ctx, AddRatingHistoryParams{
+		EnemyID:   enmy.ID,
+		Rating:    enmy.Rating,
+		ChangedAt: enmy.LastUpdated,
+	}); err != nil {
+		return nil, err
+	}
+	after, err := e.snapshot(ctx, q, enmy)
+	if err != nil {
+		return nil, err
+	}
+	if err := audit(ctx, q, "AddEnemy", nil, after); err != nil {
+		return nil, err
+	}
+	if err := notify(ctx, q, enemy.EnemyEvent_ADDED, after); err != nil {
 		return nil, err
 	}
 	return &enemy.AddEnemyResponse{
-		Enemy: &enemy.Enemy{
-			Id:          enmy.EnemyID,
-			Name:        enmy.FullName,
-			Email:       enmy.Email,
-			Rating:      enmy.Rating,
-			LastUpdated: timestamppb.New(enmy.LastUpdated),
-		},
+		Enemy: after,
 	}, nil
 }
 
 func (e *EnemyStore) GetEnemy(ctx context.Context, req *enemy.GetEnemyRequest) (*enemy.GetEnemyResponse, error) {
-	enmy, err := e.queries.GetEnemy(ctx, req.GetId())
+	var res *enemy.GetEnemyResponse
+	var err error
+	if req.GetReadTime() != nil {
+		res, err = e.getEnemyAsOf(ctx, req)
+	} else {
+		res, err = e.getEnemy(ctx, req)
+	}
 	if err != nil {
 		return nil, err
 	}
+	if req.GetIncludeGrievances() {
+		if err := e.grievanceSummary(ctx, res); err != nil {
+			return nil, dbError(err)
+		}
+	}
+	if req.GetIncludeRank() {
+		if err := e.rank(ctx, res); err != nil {
+			return nil, dbError(err)
+		}
+	}
+	return res, nil
+}
+
+func (e *EnemyStore) getEnemy(ctx context.Context, req *enemy.GetEnemyRequest) (*enemy.GetEnemyResponse, error) {
+	enmy, err := e.queries.GetEnemy(ctx, GetEnemyParams{
+		EnemyID:     req.GetId(),
+		ShowDeleted: req.GetShowDeleted(),
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	res := toProto(enmy)
+	if err := e.loadDetails(ctx, e.queries, voter(ctx), res); err != nil {
+		return nil, dbError(err)
+	}
+	return &enemy.GetEnemyResponse{
+		Enemy: res,
+	}, nil
+}
+
+// getEnemyAsOf reads the enemy from its versions rather than its current
+// state. Tags aren't versioned, so they are always the current ones.
+func (e *EnemyStore) getEnemyAsOf(ctx context.Context, req *enemy.GetEnemyRequest) (*enemy.GetEnemyResponse, error) {
+	row, err := e.queries.GetEnemyAsOf(ctx, GetEnemyAsOfParams{
+		EnemyID:  req.GetId(),
+		ReadTime: req.GetReadTime().AsTime(),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errorf(ErrNotFound, "enemy %s didn't exist at %s", req.GetId(), req.GetReadTime().AsTime().Format(time.RFC3339))
+	}
+	if err != nil {
+		return nil, dbError(err)
+	}
+	if row.DeletedAt.Valid && !req.GetShowDeleted() {
+		return nil, errorf(ErrNotFound, "enemy %s was deleted at %s", req.GetId(), req.GetReadTime().AsTime().Format(time.RFC3339))
+	}
+	res := toProto(Enemy(row))
+	if err := e.loadDetails(ctx, e.queries, voter(ctx), res); err != nil {
+		return nil, dbError(err)
+	}
 	return &enemy.GetEnemyResponse{
-		Enemy: &enemy.Enemy{
-			Id:          enmy.EnemyID,
-			Name:        enmy.FullName,
-			Email:       enmy.Email,
-			Rating:      enmy.Rating,
-			LastUpdated: timestamppb.New(enmy.LastUpdated),
-		},
+		Enemy: res,
 	}, nil
 }
 
 func (e *EnemyStore) UpdateEnemy(ctx context.Context, req *enemy.UpdateEnemyRequest) (*enemy.UpdateEnemyResponse, error) {
-	enmy, err := e.queries.UpdateEnemy(ctx, UpdateEnemyParams{
-		FullName:    req.Name,
-		Email:       req.Email,
-		Rating:      req.Rating,
-		LastUpdated: now(),
-		EnemyID:     req.Id,
+	var enmy *enemy.Enemy
+	err := e.inTx(ctx, func(q *Queries) error {
+		var err error
+		enmy, err = e.updateEnemy(ctx, q, req)
+		return err
 	})
 	if err != nil {
-		return nil, err
+		return nil, dbError(err)
 	}
 	return &enemy.UpdateEnemyResponse{
-		Enemy: &enemy.Enemy{
-			Id:          enmy.EnemyID,
-			Name:        enmy.FullName,
-			Email:       enmy.Email,
-			Rating:      enmy.Rating,
-			LastUpdated: timestamppb.New(enmy.LastUpdated),
-		},
+		Enemy: enmy,
 	}, nil
 }
 
-func (e *EnemyStore) ListEnemies(ctx context.Context, req *enemy.ListEnemiesRequest) (*enemy.ListEnemiesResponse, error) {
-	enemies, err := e.queries.ListEnemies(ctx)
+func (e *EnemyStore) updateEnemy(ctx context.Context, q *Queries, req *enemy.UpdateEnemyRequest) (*enemy.Enemy, error) {
+	params := UpdateEnemyParams{
+		SetFullName: req.GetName() != "",
+		FullName:    req.GetName(),
+		SetEmail:    req.GetEmail() != "",
+		Email:       req.GetEmail(),
+		SetRating:   req.GetRating() != 0.0,
+		Rating:      req.GetRating(),
+		LastUpdated: now(),
+		EnemyID:     req.GetId(),
+	}
+	setTags := len(req.GetTags()) > 0
+	if paths := req.GetUpdateMask().GetPaths(); len(paths) > 0 {
+		params.SetFullName, params.SetEmail, params.SetRating, setTags = false, false, false, false
+		for _, path := range paths {
+			switch path {
+			case "name":
+				params.SetFullName = true
+			case "email":
+				params.SetEmail = true
+			case "rating":
+				params.SetRating = true
+			case "tags":
+				setTags = true
+			default:
+				return nil, errorf(ErrInvalidArgument, "invalid update mask path %q", path)
+			}
+		}
+	}
+	var version int32
+	if req.GetEtag() != "" {
+		var err error
+		if version, err = parseEtag(req.GetEtag()); err != nil {
+			return nil, err
+		}
+	}
+	current, err := q.GetEnemyForUpdate(ctx, req.GetId())
+	if err == nil && current.DeletedAt.Valid {
+		err = sql.ErrNoRows
+	}
 	if err != nil {
 		return nil, err
 	}
-	var res []*enemy.Enemy
-	for _, enmy := range enemies {
-		res = append(res, &enemy.Enemy{
-			Id:          enmy.EnemyID,
-			Name:        enmy.FullName,
-			Email:       enmy.Email,
-			Rating:      enmy.Rating,
-			LastUpdated: timestamppb.New(enmy.LastUpdated),
+	if req.GetEtag() != "" && current.Version != version {
+		return nil, errorf(ErrAborted, "etag %q doesn't match the current version of enemy %s", req.GetEtag(), req.GetId())
+	}
+	before, err := e.snapshot(ctx, q, current)
+	if err != nil {
+		return nil, err
+	}
+	enmy, err := q.UpdateEnemy(ctx, params)
+	if err != nil {
+		return nil, e.conflictError(ctx, err, params.Email)
+	}
+	if setTags {
+		if err := q.ClearEnemyTags(ctx, enmy.ID); err != nil {
+			return nil, err
+		}
+		if err := q.AddEnemyTags(ctx, AddEnemyTagsParams{ID: enmy.ID, Tags: textArray(req.GetTags())}); err != nil {
+			return nil, err
+		}
+	}
+	if err := q.AddEnemyVersion(ctx, AddEnemyVersionParams{ID: enmy.ID, ValidFrom: enmy.LastUpdated}); err != nil {
+		return nil, err
+	}
+	if params.SetRating {
+		if err := q.AddRatingHistory(ctx, AddRatingHistoryParams{
+			EnemyID:   enmy.ID,
+			Rating:    enmy.Rating,
+			ChangedAt: enmy.LastUpdated,
+		}); err != nil {
+			return nil, err
+		}
+	}
+	after, err := e.snapshot(ctx, q, enmy)
+	if err != nil {
+		return nil, err
+	}
+	if err := audit(ctx, q, "UpdateEnemy", before, after); err != nil {
+		return nil, err
+	}
+	if err := notify(ctx, q, enemy.EnemyEvent_UPDATED, after); err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+func (e *EnemyStore) ListEnemies(ctx context.Context, req *enemy.ListEnemiesRequest) (*enemy.ListEnemiesResponse, error) {
+	fltr, err := filter.Parse(req.GetFilter())
+	if err != nil {
+		return nil, errorf(ErrInvalidArgument, "invalid filter: %v", err)
+	}
+	terms, err := orderby.Parse(req.GetOrderBy())
+	if err != nil {
+		return nil, errorf(ErrInvalidArgument, "invalid order by: %v", err)
+	}
+	orderBy := orderby.String(terms)
+	var after *Enemy
+	if req.GetPageToken() != "" {
+		token, err := decodePageToken(req.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+		if token.OrderBy != orderBy {
+			return nil, errorf(ErrInvalidArgument, "page token doesn't match order by")
+		}
+		after = token.row()
+	}
+	var readTime *time.Time
+	if req.GetReadTime() != nil {
+		t := req.GetReadTime().AsTime()
+		readTime = &t
+	}
+	var enemies []Enemy
+	n, nextPageToken, err := page(req.GetPageSize(), func(limit int32) (int, error) {
+		var err error
+		enemies, err = e.queries.ListEnemies(ctx, ListEnemiesParams{
+			ReadTime:    readTime,
+			ShowDeleted: req.GetShowDeleted(),
+			Filter:      fltr,
+			AnyTags:     req.GetAnyTags(),
+			AllTags:     req.GetAllTags(),
+			OrderBy:     terms,
+			After:       after,
+			RowLimit:    limit,
 		})
+		return len(enemies), err
+	}, func(last int) string {
+		return newPageToken(orderBy, enemies[last]).encode()
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	var res []*enemy.Enemy
+	for _, enmy := range enemies[:n] {
+		res = append(res, toProto(enmy))
+	}
+	if err := e.loadDetails(ctx, e.queries, voter(ctx), res...); err != nil {
+		return nil, dbError(err)
 	}
 	return &enemy.ListEnemiesResponse{
-		Enemies: res,
+		Enemies:       res,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (e *EnemyStore) DeleteEnemy(ctx context.Context, req *enemy.DeleteEnemyRequest) (*enemy.DeleteEnemyResponse, error) {
+	var after *enemy.Enemy
+	err := e.inTx(ctx, func(q *Queries) error {
+		current, err := q.GetEnemyForUpdate(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		before, err := e.snapshot(ctx, q, current)
+		if err != nil {
+			return err
+		}
+		enmy, err := q.DeleteEnemy(ctx, DeleteEnemyParams{
+			DeletedAt: now(),
+			EnemyID:   req.GetId(),
+		})
+		if err != nil {
+			return err
+		}
+		if err := q.AddEnemyVersion(ctx, AddEnemyVersionParams{ID: enmy.ID, ValidFrom: enmy.DeletedAt.Time}); err != nil {
+			return err
+		}
+		if after, err = e.snapshot(ctx, q, enmy); err != nil {
+			return err
+		}
+		if err := audit(ctx, q, "DeleteEnemy", before, after); err != nil {
+			return err
+		}
+		return notify(ctx, q, enemy.EnemyEvent_DELETED, after)
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &enemy.DeleteEnemyResponse{
+		Enemy: after,
+	}, nil
+}
+
+func (e *EnemyStore) UndeleteEnemy(ctx context.Context, req *enemy.UndeleteEnemyRequest) (*enemy.UndeleteEnemyResponse, error) {
+	var after *enemy.Enemy
+	err := e.inTx(ctx, func(q *Queries) error {
+		current, err := q.GetEnemyForUpdate(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		before, err := e.snapshot(ctx, q, current)
+		if err != nil {
+			return err
+		}
+		enmy, err := q.UndeleteEnemy(ctx, req.GetId())
+		if err != nil {
+			return e.conflictError(ctx, err, current.Email)
+		}
+		if err := q.AddEnemyVersion(ctx, AddEnemyVersionParams{ID: enmy.ID, ValidFrom: now()}); err != nil {
+			return err
+		}
+		if after, err = e.snapshot(ctx, q, enmy); err != nil {
+			return err
+		}
+		if err := audit(ctx, q, "UndeleteEnemy", before, after); err != nil {
+			return err
+		}
+		return notify(ctx, q, enemy.EnemyEvent_ADDED, after)
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &enemy.UndeleteEnemyResponse{
+		Enemy: after,
+	}, nil
+}
+
+func (e *EnemyStore) PurgeEnemy(ctx context.Context, req *enemy.PurgeEnemyRequest) (*enemy.PurgeEnemyResponse, error) {
+	var before *enemy.Enemy
+	err := e.inTx(ctx, func(q *Queries) error {
+		current, err := q.GetEnemyForUpdate(ctx, req.GetId())
+		if err != nil {
+			return err
+		}
+		// Purging deletes the tags along with the enemy, so read them first.
+		if before, err = e.snapshot(ctx, q, current); err != nil {
+			return err
+		}
+		if _, err := q.PurgeEnemy(ctx, req.GetId()); err != nil {
+			return err
+		}
+		if err := audit(ctx, q, "PurgeEnemy", before, nil); err != nil {
+			return err
+		}
+		return notify(ctx, q, enemy.EnemyEvent_DELETED, before)
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return &enemy.PurgeEnemyResponse{Enemy: before}, nil
+}
+
+func (e *EnemyStore) GetRatingHistory(ctx context.Context, req *enemy.GetRatingHistoryRequest) (*enemy.GetRatingHistoryResponse, error) {
+	token, err := decodeHistoryPageToken(req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := e.queries.GetEnemy(ctx, GetEnemyParams{EnemyID: req.GetId(), ShowDeleted: true}); err != nil {
+		return nil, dbError(err)
+	}
+	var startTime time.Time
+	if req.GetStartTime() != nil {
+		startTime = req.GetStartTime().AsTime()
+	}
+	endTime := maxTime
+	if req.GetEndTime() != nil {
+		endTime = req.GetEndTime().AsTime()
+	}
+	var points []EnemyRatingHistory
+	n, nextPageToken, err := page(req.GetPageSize(), func(limit int32) (int, error) {
+		var err error
+		points, err = e.queries.ListRatingHistory(ctx, ListRatingHistoryParams{
+			EnemyID:        req.GetId(),
+			StartTime:      startTime,
+			EndTime:        endTime,
+			AfterChangedAt: token.ChangedAt,
+			AfterID:        token.LastID,
+			RowLimit:       limit,
+		})
+		return len(points), err
+	}, func(last int) string {
+		return historyPageToken{ChangedAt: points[last].ChangedAt, LastID: points[last].ID}.encode()
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	var res []*enemy.RatingPoint
+	for _, point := range points[:n] {
+		res = append(res, &enemy.RatingPoint{
+			Rating:    point.Rating,
+			ChangedAt: timestamppb.New(point.ChangedAt),
+		})
+	}
+	return &enemy.GetRatingHistoryResponse{
+		Points:        res,
+		NextPageToken: nextPageToken,
 	}, nil
 }
+
+// inTx runs fn in a transaction, which is committed if fn returns nil and
+// rolled back otherwise.
+func (e *EnemyStore) inTx(ctx context.Context, fn func(q *Queries) error) error {
+	return e.inTxWith(ctx, nil, fn)
+}
+
+// errRollback rolls back the transaction of inRolledBackTx.
+var errRollback = errors.New("rollback")
+
+// inRolledBackTx runs fn in a transaction that is always rolled back, to find
+// out whether fn would succeed without changing anything.
+func (e *EnemyStore) inRolledBackTx(ctx context.Context, fn func(q *Queries) error) error {
+	err := e.inTx(ctx, func(q *Queries) error {
+		if err := fn(q); err != nil {
+			return err
+		}
+		return errRollback
+	})
+	if errors.Is(err, errRollback) {
+		return nil
+	}
+	return err
+}
+
+// inSnapshot runs fn in a read-only transaction that sees a single snapshot of
+// the database, for reads that have to agree with each other.
+func (e *EnemyStore) inSnapshot(ctx context.Context, fn func(q *Queries) error) error {
+	return e.inTxWith(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, fn)
+}
+
+func (e *EnemyStore) inTxWith(ctx context.Context, opts *sql.TxOptions, fn func(q *Queries) error) error {
+	tx, err := e.db.BeginTx(ctx, opts)
+	if err != nil {
+		return err
+	}
+	if err := fn(e.queries.WithTx(tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// conflictError is like dbError, but points out which enemy is already using
+// email when that is what went wrong.
+func (e *EnemyStore) conflictError(ctx context.Context, err error, email string) error {
+	var pgErr pgx.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != pgUniqueViolation || pgErr.ConstraintName != emailConstraint {
+		return dbError(err)
+	}
+	other, lookupErr := e.queries.GetEnemyByEmail(ctx, email)
+	if lookupErr != nil {
+		return dbError(err)
+	}
+	return &Error{
+		Kind: ErrConflict,
+		Msg:  fmt.Sprintf("email %q is already used by enemy %s", email, other.EnemyID),
+		ID:   other.EnemyID,
+	}
+}
+
+// The etag is the version of the enemy, but clients should treat it as
+// opaque.
+func etag(version int32) string {
+	return strconv.Itoa(int(version))
+}
+
+func parseEtag(s string) (int32, error) {
+	version, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, errorf(ErrInvalidArgument, "invalid etag %q", s)
+	}
+	return int32(version), nil
+}
+
+func toProto(enmy Enemy) *enemy.Enemy {
+	res := &enemy.Enemy{
+		Id:          enmy.EnemyID,
+		Name:        enmy.FullName,
+		Email:       enmy.Email,
+		Rating:      enmy.Rating,
+		LastUpdated: timestamppb.New(enmy.LastUpdated),
+		Etag:        etag(enmy.Version),
+	}
+	if enmy.DeletedAt.Valid {
+		res.DeletedAt = timestamppb.New(enmy.DeletedAt.Time)
+	}
+	return res
+}
+
+// touchEnemy changes data of an enemy kept outside the enemies table, like its
+// tags, using fn. Such data isn't versioned, but the change still bumps the
+// version of the enemy so that etags and watchers pick it up.
+func (e *EnemyStore) touchEnemy(ctx context.Context, method, enemyID string, fn func(q *Queries, id int32) error) (*enemy.Enemy, error) {
+	var after *enemy.Enemy
+	err := e.inTx(ctx, func(q *Queries) error {
+		current, err := q.GetEnemyForUpdate(ctx, enemyID)
+		if err == nil && current.DeletedAt.Valid {
+			err = sql.ErrNoRows
+		}
+		if err != nil {
+			return err
+		}
+		before, err := e.snapshot(ctx, q, current)
+		if err != nil {
+			return err
+		}
+		if err := fn(q, current.ID); err != nil {
+			return err
+		}
+		enmy, err := q.TouchEnemy(ctx, TouchEnemyParams{LastUpdated: now(), EnemyID: enemyID})
+		if err != nil {
+			return err
+		}
+		if err := q.AddEnemyVersion(ctx, AddEnemyVersionParams{ID: enmy.ID, ValidFrom: enmy.LastUpdated}); err != nil {
+			return err
+		}
+		if after, err = e.snapshot(ctx, q, enmy); err != nil {
+			return err
+		}
+		if err := audit(ctx, q, method, before, after); err != nil {
+			return err
+		}
+		return notify(ctx, q, enemy.EnemyEvent_UPDATED, after)
+	})
+	if err != nil {
+		return nil, dbError(err)
+	}
+	return after, nil
+}
+
+// snapshot returns enmy with its tags and votes as seen by q. It doesn't have
+// the vote of the caller, as snapshots end up in the audit log and events.
+func (e *EnemyStore) snapshot(ctx context.Context, q *Queries, enmy Enemy) (*enemy.Enemy, error) {
+	res := toProto(enmy)
+	if err := e.loadDetails(ctx, q, "", res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// loadDetails fills in the parts of enmys kept outside the enemies table: their
+// tags and votes, including the vote of voter unless it's empty.
+func (e *EnemyStore) loadDetails(ctx context.Context, q *Queries, voter string, enmys ...*enemy.Enemy) error {
+	if err := loadTags(ctx, q, enmys...); err != nil {
+		return err
+	}
+	return e.loadVotes(ctx, q, voter, enmys...)
+}
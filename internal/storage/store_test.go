@@ -3,14 +3,20 @@ package storage
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/jackc/pgx"
 	_ "github.com/jackc/pgx/stdlib"
 	"github.com/larwef/rpi-docker-test/pkg/enemy"
 	postgresdocker "github.com/larwef/rpi-docker-test/test/postgres-docker"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	gotestAssert "gotest.tools/v3/assert"
 )
@@ -28,7 +34,7 @@ func TestEnemyStore_AddEnemy(t *testing.T) {
 
 	now = func() time.Time { return time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC) }
 	id = func() string { return "someID" }
-	res, err := es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{
+	res, _, err := es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{
 		Name:   "Voldemort",
 		Email:  "voldemort@bar.com",
 		Rating: 10.0,
@@ -41,6 +47,7 @@ func TestEnemyStore_AddEnemy(t *testing.T) {
 			Email:       "voldemort@bar.com",
 			Rating:      10.0,
 			LastUpdated: timestamppb.New(time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC)),
+			Etag:        "1",
 		},
 	}, res, protocmp.Transform())
 }
@@ -69,6 +76,7 @@ func TestEnemyStore_GetEnemy(t *testing.T) {
 			Email:       "voldemort@bar.com",
 			Rating:      9.9,
 			LastUpdated: timestamppb.New(time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC)),
+			Etag:        "1",
 		},
 	}, res, protocmp.Transform())
 }
@@ -102,6 +110,7 @@ func TestEnemyStore_UpdateEnemy(t *testing.T) {
 			Email:       "voldemort@foo.com",
 			Rating:      11.0,
 			LastUpdated: timestamppb.New(time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC)),
+			Etag:        "2",
 		},
 	}, res, protocmp.Transform())
 }
@@ -139,6 +148,7 @@ func TestEnemyStore_ListEnemy(t *testing.T) {
 				Email:       "enemy1@bar.com",
 				Rating:      1.1,
 				LastUpdated: timestamppb.New(time.Date(2021, time.December, 1, 11, 59, 5, 0, time.UTC)),
+				Etag:        "1",
 			},
 			{
 				Id:          "enemy2",
@@ -146,6 +156,7 @@ func TestEnemyStore_ListEnemy(t *testing.T) {
 				Email:       "enemy2@bar.com",
 				Rating:      2.2,
 				LastUpdated: timestamppb.New(time.Date(2021, time.December, 2, 12, 59, 5, 0, time.UTC)),
+				Etag:        "1",
 			},
 			{
 				Id:          "enemy3",
@@ -153,6 +164,7 @@ func TestEnemyStore_ListEnemy(t *testing.T) {
 				Email:       "enemy3@bar.com",
 				Rating:      3.3,
 				LastUpdated: timestamppb.New(time.Date(2021, time.December, 3, 13, 59, 5, 0, time.UTC)),
+				Etag:        "1",
 			},
 			{
 				Id:          "enemy4",
@@ -160,6 +172,7 @@ func TestEnemyStore_ListEnemy(t *testing.T) {
 				Email:       "enemy4@bar.com",
 				Rating:      4.4,
 				LastUpdated: timestamppb.New(time.Date(2021, time.December, 4, 14, 59, 5, 0, time.UTC)),
+				Etag:        "1",
 			},
 			{
 				Id:          "enemy5",
@@ -167,7 +180,1426 @@ func TestEnemyStore_ListEnemy(t *testing.T) {
 				Email:       "enemy5@bar.com",
 				Rating:      5.5,
 				LastUpdated: timestamppb.New(time.Date(2021, time.December, 5, 15, 59, 5, 0, time.UTC)),
+				Etag:        "1",
 			},
 		},
 	}, res, protocmp.Transform())
 }
+
+func TestEnemyStore_DeleteEnemy(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);",
+		"enemyID", "Voldemort", "voldemort@bar.com", 9.9, time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC))
+	assert.NoError(t, err)
+
+	now = func() time.Time { return time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC) }
+	want := &enemy.Enemy{
+		Id:          "enemyID",
+		Name:        "Voldemort",
+		Email:       "voldemort@bar.com",
+		Rating:      9.9,
+		LastUpdated: timestamppb.New(time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC)),
+		DeletedAt:   timestamppb.New(time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC)),
+		Etag:        "2",
+	}
+	res, err := es.DeleteEnemy(context.Background(), &enemy.DeleteEnemyRequest{Id: "enemyID"})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.DeleteEnemyResponse{Enemy: want}, res, protocmp.Transform())
+
+	_, err = es.DeleteEnemy(context.Background(), &enemy.DeleteEnemyRequest{Id: "enemyID"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemyID"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	getRes, err := es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemyID", ShowDeleted: true})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.GetEnemyResponse{Enemy: want}, getRes, protocmp.Transform())
+
+	listRes, err := es.ListEnemies(context.Background(), &enemy.ListEnemiesRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, listRes.GetEnemies())
+
+	listRes, err = es.ListEnemies(context.Background(), &enemy.ListEnemiesRequest{ShowDeleted: true})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.ListEnemiesResponse{Enemies: []*enemy.Enemy{want}}, listRes, protocmp.Transform())
+}
+
+func TestEnemyStore_UndeleteEnemy(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated, deleted_at) VALUES ($1, $2, $3, $4, $5, $6);",
+		"enemyID", "Voldemort", "voldemort@bar.com", 9.9, time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC), time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+
+	res, err := es.UndeleteEnemy(context.Background(), &enemy.UndeleteEnemyRequest{Id: "enemyID"})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.UndeleteEnemyResponse{
+		Enemy: &enemy.Enemy{
+			Id:          "enemyID",
+			Name:        "Voldemort",
+			Email:       "voldemort@bar.com",
+			Rating:      9.9,
+			LastUpdated: timestamppb.New(time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC)),
+			Etag:        "2",
+		},
+	}, res, protocmp.Transform())
+
+	_, err = es.UndeleteEnemy(context.Background(), &enemy.UndeleteEnemyRequest{Id: "enemyID"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = db.Exec("INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated, deleted_at) VALUES ($1, $2, $3, $4, $5, $6);",
+		"enemyID2", "Tom Riddle", "Voldemort@bar.com", 5, time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC), time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+
+	_, err = es.UndeleteEnemy(context.Background(), &enemy.UndeleteEnemyRequest{Id: "enemyID2"})
+	assert.Equal(t, &Error{Kind: ErrConflict, Msg: `email "Voldemort@bar.com" is already used by enemy enemyID`, ID: "enemyID"}, err)
+}
+
+func TestEnemyStore_PurgeEnemy(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);",
+		"enemyID", "Voldemort", "voldemort@bar.com", 9.9, time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC))
+	assert.NoError(t, err)
+
+	res, err := es.PurgeEnemy(context.Background(), &enemy.PurgeEnemyRequest{Id: "enemyID"})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.PurgeEnemyResponse{
+		Enemy: &enemy.Enemy{
+			Id:          "enemyID",
+			Name:        "Voldemort",
+			Email:       "voldemort@bar.com",
+			Rating:      9.9,
+			LastUpdated: timestamppb.New(time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC)),
+			Etag:        "1",
+		},
+	}, res, protocmp.Transform())
+
+	_, err = es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemyID", ShowDeleted: true})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEnemyStore_ListEnemies_Pagination(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	for _, enemyID := range []string{"enemy1", "enemy2", "enemy3", "enemy4", "enemy5"} {
+		_, err = db.Exec(q, enemyID, "Some Enemy", enemyID+"@bar.com", 1.1, time.Date(2021, time.December, 1, 11, 59, 5, 0, time.UTC))
+		assert.NoError(t, err)
+	}
+
+	var pages [][]string
+	req := &enemy.ListEnemiesRequest{PageSize: 2}
+	for {
+		res, err := es.ListEnemies(context.Background(), req)
+		assert.NoError(t, err)
+		var ids []string
+		for _, enmy := range res.GetEnemies() {
+			ids = append(ids, enmy.GetId())
+		}
+		pages = append(pages, ids)
+		if res.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+	assert.Equal(t, [][]string{{"enemy1", "enemy2"}, {"enemy3", "enemy4"}, {"enemy5"}}, pages)
+
+	_, err = es.ListEnemies(context.Background(), &enemy.ListEnemiesRequest{PageToken: "not a token"})
+	assert.Equal(t, errorf(ErrInvalidArgument, "invalid page token"), err)
+}
+
+func TestEnemyStore_ListEnemies_Filter(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	_, err = db.Exec(q, "enemy1", "Enemy One", "enemy1@corp.com", 9.5, time.Date(2021, time.December, 1, 11, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+	_, err = db.Exec(q, "enemy2", "Enemy Two", "enemy2@bar.com", 8.0, time.Date(2021, time.December, 2, 12, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+	_, err = db.Exec(q, "enemy3", "Enemy Three", "enemy3@corp.com", 3.3, time.Date(2021, time.December, 3, 13, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+	_, err = db.Exec(q, "enemy4", "Enemy 100%", "enemy4@corp.com", 4.4, time.Date(2021, time.December, 4, 14, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+
+	tests := []struct {
+		give string
+		want []string
+	}{
+		{give: `rating > 7 AND email = "*@corp.com"`, want: []string{"enemy1"}},
+		{give: `rating < 4 OR name = "Enemy Two"`, want: []string{"enemy2", "enemy3"}},
+		{give: `NOT email = "*@corp.com"`, want: []string{"enemy2"}},
+		{give: `name:"100%"`, want: []string{"enemy4"}},
+		{give: `last_updated >= "2021-12-03T00:00:00Z"`, want: []string{"enemy3", "enemy4"}},
+	}
+	for _, test := range tests {
+		res, err := es.ListEnemies(context.Background(), &enemy.ListEnemiesRequest{Filter: test.give})
+		assert.NoError(t, err)
+		var ids []string
+		for _, enmy := range res.GetEnemies() {
+			ids = append(ids, enmy.GetId())
+		}
+		assert.Equal(t, test.want, ids, test.give)
+	}
+
+	_, err = es.ListEnemies(context.Background(), &enemy.ListEnemiesRequest{Filter: "rating >"})
+	assert.Equal(t, errorf(ErrInvalidArgument, "invalid filter: unexpected end of filter at position 9"), err)
+}
+
+func TestEnemyStore_ListEnemies_OrderBy(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	_, err = db.Exec(q, "enemy1", "Charlie", "enemy1@bar.com", 5.0, time.Date(2021, time.December, 1, 11, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+	_, err = db.Exec(q, "enemy2", "Alice", "enemy2@bar.com", 9.0, time.Date(2021, time.December, 2, 12, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+	_, err = db.Exec(q, "enemy3", "Bob", "enemy3@bar.com", 5.0, time.Date(2021, time.December, 3, 13, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+	_, err = db.Exec(q, "enemy4", "Alice", "enemy4@bar.com", 5.0, time.Date(2021, time.December, 4, 14, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+	_, err = db.Exec(q, "enemy5", "Dave", "enemy5@bar.com", 1.0, time.Date(2021, time.December, 5, 15, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+
+	var ids []string
+	req := &enemy.ListEnemiesRequest{PageSize: 2, OrderBy: "rating desc, name"}
+	for {
+		res, err := es.ListEnemies(context.Background(), req)
+		assert.NoError(t, err)
+		for _, enmy := range res.GetEnemies() {
+			ids = append(ids, enmy.GetId())
+		}
+		if res.GetNextPageToken() == "" {
+			break
+		}
+		req.PageToken = res.GetNextPageToken()
+	}
+	assert.Equal(t, []string{"enemy2", "enemy4", "enemy3", "enemy1", "enemy5"}, ids)
+
+	res, err := es.ListEnemies(context.Background(), &enemy.ListEnemiesRequest{PageSize: 2, OrderBy: "name"})
+	assert.NoError(t, err)
+	_, err = es.ListEnemies(context.Background(), &enemy.ListEnemiesRequest{PageToken: res.GetNextPageToken(), OrderBy: "rating"})
+	assert.Equal(t, errorf(ErrInvalidArgument, "page token doesn't match order by"), err)
+}
+
+func TestEnemyStore_UpdateEnemy_UpdateMask(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);",
+		"enemyID", "Voldemort", "voldemort@bar.com", 9.9, time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC))
+	assert.NoError(t, err)
+
+	now = func() time.Time { return time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC) }
+	res, err := es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{
+		Id:         "enemyID",
+		Name:       "Tom Riddle",
+		Rating:     0.0,
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"rating"}},
+	})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.UpdateEnemyResponse{
+		Enemy: &enemy.Enemy{
+			Id:          "enemyID",
+			Name:        "Voldemort",
+			Email:       "voldemort@bar.com",
+			Rating:      0.0,
+			LastUpdated: timestamppb.New(time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC)),
+			Etag:        "2",
+		},
+	}, res, protocmp.Transform())
+
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{
+		Id:         "enemyID",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"rating", "id"}},
+	})
+	assert.Equal(t, errorf(ErrInvalidArgument, `invalid update mask path "id"`), err)
+}
+
+func TestEnemyStore_AddEnemy_Conflict(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	_, err = db.Exec(q, "enemy1", "Voldemort", "voldemort@bar.com", 9.9, time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC))
+	assert.NoError(t, err)
+	_, err = db.Exec(q, "enemy2", "Draco", "draco@bar.com", 5.5, time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC))
+	assert.NoError(t, err)
+
+	id = func() string { return "someID" }
+	_, _, err = es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{
+		Name:   "Tom Riddle",
+		Email:  "Voldemort@Bar.com",
+		Rating: 10.0,
+	})
+	assert.Equal(t, &Error{Kind: ErrConflict, Msg: `email "Voldemort@Bar.com" is already used by enemy enemy1`, ID: "enemy1"}, err)
+
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{
+		Id:    "enemy2",
+		Email: "VOLDEMORT@bar.com",
+	})
+	assert.Equal(t, &Error{Kind: ErrConflict, Msg: `email "VOLDEMORT@bar.com" is already used by enemy enemy1`, ID: "enemy1"}, err)
+
+	_, err = es.DeleteEnemy(context.Background(), &enemy.DeleteEnemyRequest{Id: "enemy1"})
+	assert.NoError(t, err)
+	_, _, err = es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{
+		Name:   "Tom Riddle",
+		Email:  "Voldemort@Bar.com",
+		Rating: 10.0,
+	})
+	assert.NoError(t, err)
+}
+
+func TestEnemyStore_AddEnemy_IdempotencyKey(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	now = func() time.Time { return time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC) }
+	id = func() string { return "someID" }
+	req := &enemy.AddEnemyRequest{
+		Name:           "Voldemort",
+		Email:          "voldemort@bar.com",
+		Rating:         10.0,
+		IdempotencyKey: "someKey",
+	}
+	want := &enemy.AddEnemyResponse{
+		Enemy: &enemy.Enemy{
+			Id:          "someID",
+			Name:        "Voldemort",
+			Email:       "voldemort@bar.com",
+			Rating:      10.0,
+			LastUpdated: timestamppb.New(time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC)),
+			Etag:        "1",
+		},
+	}
+	res, replayed, err := es.AddEnemy(context.Background(), req)
+	assert.NoError(t, err)
+	assert.False(t, replayed)
+	gotestAssert.DeepEqual(t, want, res, protocmp.Transform())
+
+	// Replay gets the original response instead of a conflict.
+	id = func() string { return "someOtherID" }
+	res, replayed, err = es.AddEnemy(context.Background(), req)
+	assert.NoError(t, err)
+	assert.True(t, replayed)
+	gotestAssert.DeepEqual(t, want, res, protocmp.Transform())
+
+	_, _, err = es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{
+		Name:           "Voldemort",
+		Email:          "voldemort@bar.com",
+		Rating:         9.0,
+		IdempotencyKey: "someKey",
+	})
+	assert.ErrorIs(t, err, ErrPrecondition)
+
+	// Once expired, the key can be used again.
+	now = func() time.Time { return time.Date(2022, time.January, 2, 14, 59, 5, 0, time.UTC) }
+	_, _, err = es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{
+		Name:           "Draco",
+		Email:          "draco@bar.com",
+		Rating:         5.0,
+		IdempotencyKey: "someKey",
+	})
+	assert.NoError(t, err)
+}
+
+func TestEnemyStore_UpdateEnemy_Etag(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);",
+		"enemyID", "Voldemort", "voldemort@bar.com", 9.9, time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC))
+	assert.NoError(t, err)
+
+	res, err := es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemyID"})
+	assert.NoError(t, err)
+	etag := res.GetEnemy().GetEtag()
+
+	updateRes, err := es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemyID", Rating: 5.0, Etag: etag})
+	assert.NoError(t, err)
+	assert.NotEqual(t, etag, updateRes.GetEnemy().GetEtag())
+
+	// Someone else updating with the etag they read before the first update.
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemyID", Rating: 6.0, Etag: etag})
+	assert.ErrorIs(t, err, ErrAborted)
+
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "missingID", Rating: 6.0, Etag: etag})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemyID", Rating: 6.0, Etag: "garbage"})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestEnemyStore_GetRatingHistory(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	id = func() string { return "enemyID" }
+	now = func() time.Time { return time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC) }
+	_, _, err = es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{Name: "Voldemort", Email: "voldemort@bar.com", Rating: 5.0})
+	assert.NoError(t, err)
+	now = func() time.Time { return time.Date(2021, time.December, 2, 12, 0, 0, 0, time.UTC) }
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemyID", Rating: 7.0})
+	assert.NoError(t, err)
+	// Doesn't touch the rating, so it isn't part of the history.
+	now = func() time.Time { return time.Date(2021, time.December, 3, 12, 0, 0, 0, time.UTC) }
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemyID", Name: "Tom Riddle"})
+	assert.NoError(t, err)
+	now = func() time.Time { return time.Date(2021, time.December, 4, 12, 0, 0, 0, time.UTC) }
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemyID", Rating: 9.0})
+	assert.NoError(t, err)
+
+	res, err := es.GetRatingHistory(context.Background(), &enemy.GetRatingHistoryRequest{Id: "enemyID", PageSize: 2})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, []*enemy.RatingPoint{
+		{Rating: 5.0, ChangedAt: timestamppb.New(time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC))},
+		{Rating: 7.0, ChangedAt: timestamppb.New(time.Date(2021, time.December, 2, 12, 0, 0, 0, time.UTC))},
+	}, res.GetPoints(), protocmp.Transform())
+
+	res, err = es.GetRatingHistory(context.Background(), &enemy.GetRatingHistoryRequest{Id: "enemyID", PageSize: 2, PageToken: res.GetNextPageToken()})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.GetRatingHistoryResponse{
+		Points: []*enemy.RatingPoint{
+			{Rating: 9.0, ChangedAt: timestamppb.New(time.Date(2021, time.December, 4, 12, 0, 0, 0, time.UTC))},
+		},
+	}, res, protocmp.Transform())
+
+	res, err = es.GetRatingHistory(context.Background(), &enemy.GetRatingHistoryRequest{
+		Id:        "enemyID",
+		StartTime: timestamppb.New(time.Date(2021, time.December, 2, 0, 0, 0, 0, time.UTC)),
+		EndTime:   timestamppb.New(time.Date(2021, time.December, 4, 0, 0, 0, 0, time.UTC)),
+	})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.GetRatingHistoryResponse{
+		Points: []*enemy.RatingPoint{
+			{Rating: 7.0, ChangedAt: timestamppb.New(time.Date(2021, time.December, 2, 12, 0, 0, 0, time.UTC))},
+		},
+	}, res, protocmp.Transform())
+
+	_, err = es.GetRatingHistory(context.Background(), &enemy.GetRatingHistoryRequest{Id: "missingID"})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEnemyStore_ReadTime(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	id = func() string { return "enemyID" }
+	now = func() time.Time { return time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC) }
+	_, _, err = es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{Name: "Voldemort", Email: "voldemort@bar.com", Rating: 5.0})
+	assert.NoError(t, err)
+	now = func() time.Time { return time.Date(2021, time.December, 2, 12, 0, 0, 0, time.UTC) }
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemyID", Name: "Tom Riddle", Rating: 7.0})
+	assert.NoError(t, err)
+	now = func() time.Time { return time.Date(2021, time.December, 3, 12, 0, 0, 0, time.UTC) }
+	_, err = es.DeleteEnemy(context.Background(), &enemy.DeleteEnemyRequest{Id: "enemyID"})
+	assert.NoError(t, err)
+
+	readTime := timestamppb.New(time.Date(2021, time.December, 1, 18, 0, 0, 0, time.UTC))
+	want := &enemy.Enemy{
+		Id:          "enemyID",
+		Name:        "Voldemort",
+		Email:       "voldemort@bar.com",
+		Rating:      5.0,
+		LastUpdated: timestamppb.New(time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)),
+		Etag:        "1",
+	}
+	res, err := es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemyID", ReadTime: readTime})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.GetEnemyResponse{Enemy: want}, res, protocmp.Transform())
+
+	listRes, err := es.ListEnemies(context.Background(), &enemy.ListEnemiesRequest{ReadTime: readTime})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.ListEnemiesResponse{Enemies: []*enemy.Enemy{want}}, listRes, protocmp.Transform())
+
+	res, err = es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{
+		Id:       "enemyID",
+		ReadTime: timestamppb.New(time.Date(2021, time.December, 2, 18, 0, 0, 0, time.UTC)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Tom Riddle", res.GetEnemy().GetName())
+
+	// Deleted at the read time.
+	_, err = es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{
+		Id:       "enemyID",
+		ReadTime: timestamppb.New(time.Date(2021, time.December, 3, 18, 0, 0, 0, time.UTC)),
+	})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Before the enemy was created.
+	_, err = es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{
+		Id:       "enemyID",
+		ReadTime: timestamppb.New(time.Date(2021, time.November, 30, 12, 0, 0, 0, time.UTC)),
+	})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEnemyStore_ListAuditEvents(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	id = func() string { return "enemyID" }
+	now = func() time.Time { return time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC) }
+	ctx := WithAuditInfo(context.Background(), AuditInfo{Actor: "harry", Method: "/enemy.EnemyService/AddEnemy", Peer: "10.0.0.1:4711"})
+	_, _, err = es.AddEnemy(ctx, &enemy.AddEnemyRequest{Name: "Voldemort", Email: "voldemort@bar.com", Rating: 5.0})
+	assert.NoError(t, err)
+	now = func() time.Time { return time.Date(2021, time.December, 2, 12, 0, 0, 0, time.UTC) }
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemyID", Rating: 7.0})
+	assert.NoError(t, err)
+
+	res, err := es.ListAuditEvents(context.Background(), &enemy.ListAuditEventsRequest{EnemyId: "enemyID", PageSize: 1})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, []*enemy.AuditEvent{
+		{
+			EnemyId: "enemyID",
+			Actor:   "unknown",
+			Method:  "UpdateEnemy",
+			Before: &enemy.Enemy{
+				Id:          "enemyID",
+				Name:        "Voldemort",
+				Email:       "voldemort@bar.com",
+				Rating:      5.0,
+				LastUpdated: timestamppb.New(time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)),
+				Etag:        "1",
+			},
+			After: &enemy.Enemy{
+				Id:          "enemyID",
+				Name:        "Voldemort",
+				Email:       "voldemort@bar.com",
+				Rating:      7.0,
+				LastUpdated: timestamppb.New(time.Date(2021, time.December, 2, 12, 0, 0, 0, time.UTC)),
+				Etag:        "2",
+			},
+			Time: timestamppb.New(time.Date(2021, time.December, 2, 12, 0, 0, 0, time.UTC)),
+		},
+	}, res.GetEvents(), protocmp.Transform())
+
+	res, err = es.ListAuditEvents(context.Background(), &enemy.ListAuditEventsRequest{EnemyId: "enemyID", PageSize: 1, PageToken: res.GetNextPageToken()})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.ListAuditEventsResponse{
+		Events: []*enemy.AuditEvent{
+			{
+				EnemyId: "enemyID",
+				Actor:   "harry",
+				Method:  "/enemy.EnemyService/AddEnemy",
+				Peer:    "10.0.0.1:4711",
+				After: &enemy.Enemy{
+					Id:          "enemyID",
+					Name:        "Voldemort",
+					Email:       "voldemort@bar.com",
+					Rating:      5.0,
+					LastUpdated: timestamppb.New(time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)),
+					Etag:        "1",
+				},
+				Time: timestamppb.New(time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC)),
+			},
+		},
+	}, res, protocmp.Transform())
+
+	res, err = es.ListAuditEvents(context.Background(), &enemy.ListAuditEventsRequest{Actor: "nobody"})
+	assert.NoError(t, err)
+	assert.Empty(t, res.GetEvents())
+}
+
+func TestChangeListener(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	config, err := pgx.ParseConnectionString(pg.ConnectionString())
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	changes := make(chan *enemy.EnemyEvent, 10)
+	listening := make(chan struct{})
+	go NewChangeListener(config, es).listen(ctx, changes, func() { close(listening) })
+	<-listening
+
+	id = func() string { return "enemyID" }
+	now = func() time.Time { return time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC) }
+	_, _, err = es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{Name: "Voldemort", Email: "voldemort@bar.com", Rating: 5.0})
+	assert.NoError(t, err)
+	_, err = es.DeleteEnemy(context.Background(), &enemy.DeleteEnemyRequest{Id: "enemyID"})
+	assert.NoError(t, err)
+	// Failed changes aren't announced.
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemyID", Rating: 7.0})
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, err = es.PurgeEnemy(context.Background(), &enemy.PurgeEnemyRequest{Id: "enemyID"})
+	assert.NoError(t, err)
+	// Enemies too large to fit in a notification are still announced.
+	longName := strings.Repeat("Voldemort", 1000)
+	_, _, err = es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{Name: longName, Email: "voldemort@bar.com", Rating: 5.0})
+	assert.NoError(t, err)
+
+	var types []enemy.EnemyEvent_Type
+	var last *enemy.EnemyEvent
+	for len(types) < 4 {
+		select {
+		case ev := <-changes:
+			assert.Equal(t, "enemyID", ev.GetEnemy().GetId())
+			types = append(types, ev.GetType())
+			last = ev
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for changes")
+		}
+	}
+	assert.Equal(t, []enemy.EnemyEvent_Type{enemy.EnemyEvent_ADDED, enemy.EnemyEvent_DELETED, enemy.EnemyEvent_DELETED, enemy.EnemyEvent_ADDED}, types)
+	assert.Equal(t, longName, last.GetEnemy().GetName())
+}
+
+func TestEnemyStore_BatchAddEnemies(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	ids := []string{"enemy1", "enemy2", "enemy3", "enemy4", "enemy5", "enemy6", "enemy7"}
+	id = func() string {
+		next := ids[0]
+		ids = ids[1:]
+		return next
+	}
+	now = func() time.Time { return time.Date(2021, time.December, 1, 12, 0, 0, 0, time.UTC) }
+
+	// The second enemy reuses the email of the first, so it fails on its own.
+	res, err := es.BatchAddEnemies(context.Background(), []*enemy.AddEnemyRequest{
+		{Name: "Voldemort", Email: "voldemort@bar.com", Rating: 9.9},
+		{Name: "Tom Riddle", Email: "VOLDEMORT@bar.com", Rating: 9.0},
+		{Name: "Draco", Email: "draco@bar.com", Rating: 5.5},
+	}, false)
+	assert.NoError(t, err)
+	assert.Len(t, res, 3)
+	assert.Equal(t, "enemy1", res[0].Enemy.GetId())
+	assert.ErrorIs(t, res[1].Err, ErrConflict)
+	assert.Equal(t, "enemy3", res[2].Enemy.GetId())
+
+	// Nothing is added when an atomic batch fails.
+	_, err = es.BatchAddEnemies(context.Background(), []*enemy.AddEnemyRequest{
+		{Name: "Bellatrix", Email: "bellatrix@bar.com", Rating: 8.0},
+		{Name: "Draco", Email: "draco@bar.com", Rating: 5.5},
+	}, true)
+	assert.ErrorIs(t, err, ErrConflict)
+	assert.Contains(t, err.Error(), "enemy 1: ")
+	_, err = es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemy4"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Idempotency keys work like in AddEnemy.
+	keyed := []*enemy.AddEnemyRequest{{Name: "Bellatrix", Email: "bellatrix@bar.com", Rating: 8.0, IdempotencyKey: "someKey"}}
+	res, err = es.BatchAddEnemies(context.Background(), keyed, false)
+	assert.NoError(t, err)
+	assert.False(t, res[0].Replayed)
+	addedID := res[0].Enemy.GetId()
+	res, err = es.BatchAddEnemies(context.Background(), keyed, true)
+	assert.NoError(t, err)
+	assert.True(t, res[0].Replayed)
+	assert.Equal(t, addedID, res[0].Enemy.GetId())
+}
+
+func TestEnemyStore_BatchGetEnemies(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	for _, enemyID := range []string{"enemy1", "enemy2", "enemy3"} {
+		_, err = db.Exec(q, enemyID, "Some Enemy", enemyID+"@bar.com", 1.1, time.Date(2021, time.December, 1, 11, 59, 5, 0, time.UTC))
+		assert.NoError(t, err)
+	}
+	_, err = es.DeleteEnemy(context.Background(), &enemy.DeleteEnemyRequest{Id: "enemy3"})
+	assert.NoError(t, err)
+
+	res, err := es.BatchGetEnemies(context.Background(), &enemy.BatchGetEnemiesRequest{Ids: []string{"enemy2", "missing", "enemy3", "enemy1"}})
+	assert.NoError(t, err)
+	var ids []string
+	for _, enmy := range res.GetEnemies() {
+		ids = append(ids, enmy.GetId())
+	}
+	assert.Equal(t, []string{"enemy2", "enemy1"}, ids)
+	assert.Equal(t, []string{"missing", "enemy3"}, res.GetMissingIds())
+
+	res, err = es.BatchGetEnemies(context.Background(), &enemy.BatchGetEnemiesRequest{Ids: []string{"enemy3"}, ShowDeleted: true})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetEnemies(), 1)
+	assert.Empty(t, res.GetMissingIds())
+}
+
+func TestEnemyStore_BatchUpdateEnemies(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	for _, enemyID := range []string{"enemy1", "enemy2"} {
+		_, err = db.Exec(q, enemyID, "Some Enemy", enemyID+"@bar.com", 1.1, time.Date(2021, time.December, 1, 11, 59, 5, 0, time.UTC))
+		assert.NoError(t, err)
+	}
+
+	res, err := es.BatchUpdateEnemies(context.Background(), &enemy.BatchUpdateEnemiesRequest{
+		Requests: []*enemy.UpdateEnemyRequest{
+			{Id: "enemy1", Rating: 2.2},
+			{Id: "enemy2", Name: "Draco"},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(2.2), res.GetEnemies()[0].GetRating())
+	assert.Equal(t, "Draco", res.GetEnemies()[1].GetName())
+
+	// One failing update rolls back the others.
+	_, err = es.BatchUpdateEnemies(context.Background(), &enemy.BatchUpdateEnemiesRequest{
+		Requests: []*enemy.UpdateEnemyRequest{
+			{Id: "enemy1", Rating: 3.3},
+			{Id: "missing", Rating: 3.3},
+		},
+	})
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.EqualError(t, err, "enemy 1: enemy not found")
+	getRes, err := es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemy1"})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(2.2), getRes.GetEnemy().GetRating())
+}
+
+func TestEnemyStore_BulkLoadEnemies(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);",
+		"enemyID", "Voldemort", "voldemort@bar.com", 9.9, time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC))
+	assert.NoError(t, err)
+
+	rows := []*enemy.BulkLoadEnemiesRequest{
+		{Name: "Tom Riddle", Email: "VOLDEMORT@bar.com", Rating: 9.5},
+		{Name: "Draco", Email: "draco@bar.com", Rating: 5.5},
+		{Name: "", Email: "nameless@bar.com", Rating: 1.0},
+		{Name: "Draco Malfoy", Email: "draco@bar.com", Rating: 6.5},
+	}
+	next := func() (*enemy.BulkLoadEnemiesRequest, error) {
+		if len(rows) == 0 {
+			return nil, io.EOF
+		}
+		row := rows[0]
+		rows = rows[1:]
+		return row, nil
+	}
+	res, events, err := es.BulkLoadEnemies(context.Background(), next)
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.BulkLoadEnemiesResponse{
+		Inserted: 1,
+		Updated:  1,
+		Rejected: 2,
+		RejectedRows: []*enemy.RejectedRow{
+			{Line: 2, Reason: `email "draco@bar.com" is used again on line 4`},
+			{Line: 3, Reason: "enemy name can't be empty"},
+		},
+	}, res, protocmp.Transform())
+	types := map[string]enemy.EnemyEvent_Type{}
+	for _, ev := range events {
+		types[ev.GetEnemy().GetName()] = ev.GetType()
+	}
+	assert.Equal(t, map[string]enemy.EnemyEvent_Type{
+		"Tom Riddle":   enemy.EnemyEvent_UPDATED,
+		"Draco Malfoy": enemy.EnemyEvent_ADDED,
+	}, types)
+
+	getRes, err := es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemyID"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Tom Riddle", getRes.GetEnemy().GetName())
+	assert.Equal(t, float32(9.5), getRes.GetEnemy().GetRating())
+
+	listRes, err := es.ListEnemies(context.Background(), &enemy.ListEnemiesRequest{Filter: `email = "draco@bar.com"`})
+	assert.NoError(t, err)
+	assert.Len(t, listRes.GetEnemies(), 1)
+	assert.Equal(t, "Draco Malfoy", listRes.GetEnemies()[0].GetName())
+
+	// The staging table is emptied after the load.
+	var staged int
+	assert.NoError(t, db.QueryRow("SELECT count(*) FROM enemy_staging").Scan(&staged))
+	assert.Equal(t, 0, staged)
+}
+
+func TestEnemyStore_DryRun(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	_, err = db.Exec("INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);",
+		"enemyID", "Voldemort", "voldemort@bar.com", 9.9, time.Date(2021, time.December, 30, 14, 59, 45, 0, time.UTC))
+	assert.NoError(t, err)
+
+	reqs := []*enemy.AddEnemyRequest{
+		{Name: "Tom Riddle", Email: "VOLDEMORT@bar.com", Rating: 9.5},
+		{Name: "Draco", Email: "draco@bar.com", Rating: 5.5},
+		{Name: "Draco Malfoy", Email: "draco@bar.com", Rating: 6.5},
+	}
+	rejected, err := es.DryRunAddEnemies(context.Background(), func() (*enemy.AddEnemyRequest, error) {
+		if len(reqs) == 0 {
+			return nil, io.EOF
+		}
+		req := reqs[0]
+		reqs = reqs[1:]
+		return req, nil
+	})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, []*enemy.RejectedRow{
+		{Line: 1, Reason: `email "VOLDEMORT@bar.com" is already used by enemy enemyID`},
+		// The enemy it conflicts with was only added by the dry run.
+		{Line: 3, Reason: "enemy already exists"},
+	}, rejected, protocmp.Transform())
+
+	rows := []*enemy.BulkLoadEnemiesRequest{
+		{Name: "Tom Riddle", Email: "VOLDEMORT@bar.com", Rating: 9.5},
+		{Name: "Draco", Email: "draco@bar.com", Rating: 5.5},
+	}
+	res, err := es.DryRunBulkLoadEnemies(context.Background(), func() (*enemy.BulkLoadEnemiesRequest, error) {
+		if len(rows) == 0 {
+			return nil, io.EOF
+		}
+		row := rows[0]
+		rows = rows[1:]
+		return row, nil
+	})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.BulkLoadEnemiesResponse{Inserted: 1, Updated: 1}, res, protocmp.Transform())
+
+	// Neither dry run changed anything.
+	listRes, err := es.ListEnemies(context.Background(), &enemy.ListEnemiesRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, listRes.GetEnemies(), 1)
+	assert.Equal(t, "Voldemort", listRes.GetEnemies()[0].GetName())
+	var audited int
+	assert.NoError(t, db.QueryRow("SELECT count(*) FROM audit_log").Scan(&audited))
+	assert.Equal(t, 0, audited)
+}
+
+func TestEnemyStore_Tags(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	ids := []string{"enemy1", "enemy2", "enemy3"}
+	id = func() string {
+		next := ids[0]
+		ids = ids[1:]
+		return next
+	}
+	for i, tags := range [][]string{{"school", "work"}, {"work"}, nil} {
+		_, _, err := es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{
+			Name:   "Some Enemy",
+			Email:  "enemy" + strconv.Itoa(i+1) + "@bar.com",
+			Rating: 1.1,
+			Tags:   tags,
+		})
+		assert.NoError(t, err)
+	}
+
+	listIDs := func(req *enemy.ListEnemiesRequest) []string {
+		res, err := es.ListEnemies(context.Background(), req)
+		assert.NoError(t, err)
+		var ids []string
+		for _, enmy := range res.GetEnemies() {
+			ids = append(ids, enmy.GetId())
+		}
+		return ids
+	}
+	assert.Equal(t, []string{"enemy1", "enemy2"}, listIDs(&enemy.ListEnemiesRequest{AnyTags: []string{"school", "work"}}))
+	assert.Equal(t, []string{"enemy1"}, listIDs(&enemy.ListEnemiesRequest{AllTags: []string{"school", "work"}}))
+
+	addRes, err := es.AddTags(context.Background(), &enemy.AddTagsRequest{Id: "enemy3", Tags: []string{"neighbour", "work"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"neighbour", "work"}, addRes.GetEnemy().GetTags())
+	assert.Equal(t, "2", addRes.GetEnemy().GetEtag())
+
+	removeRes, err := es.RemoveTags(context.Background(), &enemy.RemoveTagsRequest{Id: "enemy1", Tags: []string{"work"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"school"}, removeRes.GetEnemy().GetTags())
+
+	updateRes, err := es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{
+		Id:         "enemy2",
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"tags"}},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, updateRes.GetEnemy().GetTags())
+
+	_, err = es.DeleteEnemy(context.Background(), &enemy.DeleteEnemyRequest{Id: "enemy1"})
+	assert.NoError(t, err)
+
+	tagsRes, err := es.ListTags(context.Background(), &enemy.ListTagsRequest{})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.ListTagsResponse{
+		Tags: []*enemy.TagCount{
+			{Tag: "neighbour", Count: 1},
+			{Tag: "work", Count: 1},
+		},
+	}, tagsRes, protocmp.Transform())
+
+	_, err = es.AddTags(context.Background(), &enemy.AddTagsRequest{Id: "enemy1", Tags: []string{"work"}})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestEnemyStore_Grievances(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	_, err = db.Exec(q, "enemy1", "Some Enemy", "enemy1@bar.com", 1.1, time.Date(2021, time.December, 1, 11, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+
+	now = func() time.Time { return time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC) }
+	ids := []string{"grievance1", "grievance2", "grievance3"}
+	id = func() string {
+		next := ids[0]
+		ids = ids[1:]
+		return next
+	}
+	ctx := WithAuditInfo(context.Background(), AuditInfo{Actor: "harry"})
+	for i, text := range []string{"Insulted my owl", "Stole my broom", "Cursed my scar"} {
+		_, err := es.AddGrievance(ctx, &enemy.AddGrievanceRequest{
+			EnemyId:    "enemy1",
+			Text:       text,
+			Severity:   enemy.Grievance_MAJOR,
+			OccurredAt: timestamppb.New(time.Date(2021, time.December, i+1, 12, 0, 0, 0, time.UTC)),
+		})
+		assert.NoError(t, err)
+	}
+
+	_, err = es.AddGrievance(ctx, &enemy.AddGrievanceRequest{EnemyId: "missing", Text: "Who?", Severity: enemy.Grievance_MINOR})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	listRes, err := es.ListGrievances(context.Background(), &enemy.ListGrievancesRequest{EnemyId: "enemy1", PageSize: 2})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, []*enemy.Grievance{
+		{
+			Id:         "grievance3",
+			EnemyId:    "enemy1",
+			Text:       "Cursed my scar",
+			Severity:   enemy.Grievance_MAJOR,
+			OccurredAt: timestamppb.New(time.Date(2021, time.December, 3, 12, 0, 0, 0, time.UTC)),
+			CreatedBy:  "harry",
+			CreatedAt:  timestamppb.New(now()),
+		},
+		{
+			Id:         "grievance2",
+			EnemyId:    "enemy1",
+			Text:       "Stole my broom",
+			Severity:   enemy.Grievance_MAJOR,
+			OccurredAt: timestamppb.New(time.Date(2021, time.December, 2, 12, 0, 0, 0, time.UTC)),
+			CreatedBy:  "harry",
+			CreatedAt:  timestamppb.New(now()),
+		},
+	}, listRes.GetGrievances(), protocmp.Transform())
+	assert.NotEmpty(t, listRes.GetNextPageToken())
+
+	listRes, err = es.ListGrievances(context.Background(), &enemy.ListGrievancesRequest{EnemyId: "enemy1", PageSize: 2, PageToken: listRes.GetNextPageToken()})
+	assert.NoError(t, err)
+	assert.Len(t, listRes.GetGrievances(), 1)
+	assert.Equal(t, "grievance1", listRes.GetGrievances()[0].GetId())
+	assert.Empty(t, listRes.GetNextPageToken())
+
+	_, err = es.DeleteGrievance(context.Background(), &enemy.DeleteGrievanceRequest{EnemyId: "enemy1", Id: "grievance3"})
+	assert.NoError(t, err)
+	_, err = es.DeleteGrievance(context.Background(), &enemy.DeleteGrievanceRequest{EnemyId: "enemy1", Id: "grievance3"})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	getRes, err := es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemy1", IncludeGrievances: true})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), getRes.GetGrievanceCount())
+	assert.Equal(t, "grievance2", getRes.GetLatestGrievance().GetId())
+
+	// Grievances don't change the enemy, but are still audited.
+	auditRes, err := es.ListAuditEvents(context.Background(), &enemy.ListAuditEventsRequest{EnemyId: "enemy1"})
+	assert.NoError(t, err)
+	var methods []string
+	for _, ev := range auditRes.GetEvents() {
+		methods = append(methods, ev.GetActor()+" "+ev.GetMethod())
+		assert.Equal(t, "enemy1", ev.GetAfter().GetId())
+	}
+	assert.Equal(t, []string{"unknown DeleteGrievance", "harry AddGrievance", "harry AddGrievance", "harry AddGrievance"}, methods)
+}
+
+func TestEnemyStore_EnemyNetwork(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	for _, enemyID := range []string{"enemy1", "enemy2", "enemy3", "enemy4", "enemy5"} {
+		_, err = db.Exec(q, enemyID, "Some Enemy", enemyID+"@bar.com", 1.1, time.Date(2021, time.December, 1, 11, 59, 5, 0, time.UTC))
+		assert.NoError(t, err)
+	}
+
+	now = func() time.Time { return time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC) }
+	// enemy1 -> enemy2 -> enemy3 -> enemy4, plus enemy5 -> enemy1, which is
+	// deleted below.
+	links := []*enemy.LinkEnemiesRequest{
+		{FromEnemyId: "enemy1", ToEnemyId: "enemy2", Kind: enemy.Relationship_ALLY},
+		{FromEnemyId: "enemy3", ToEnemyId: "enemy2", Kind: enemy.Relationship_REPORTS_TO},
+		{FromEnemyId: "enemy3", ToEnemyId: "enemy4", Kind: enemy.Relationship_RIVAL},
+		{FromEnemyId: "enemy5", ToEnemyId: "enemy1", Kind: enemy.Relationship_RIVAL},
+	}
+	for _, link := range links {
+		_, err := es.LinkEnemies(context.Background(), link)
+		assert.NoError(t, err)
+	}
+	_, err = es.LinkEnemies(context.Background(), links[0])
+	assert.ErrorIs(t, err, ErrConflict)
+	_, err = es.LinkEnemies(context.Background(), &enemy.LinkEnemiesRequest{FromEnemyId: "enemy1", ToEnemyId: "missing", Kind: enemy.Relationship_ALLY})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = es.DeleteEnemy(context.Background(), &enemy.DeleteEnemyRequest{Id: "enemy5"})
+	assert.NoError(t, err)
+
+	res, err := es.GetEnemyNetwork(context.Background(), &enemy.GetEnemyNetworkRequest{Id: "enemy1", Depth: 2})
+	assert.NoError(t, err)
+	var nodes []string
+	for _, node := range res.GetNodes() {
+		nodes = append(nodes, fmt.Sprintf("%s:%d", node.GetEnemy().GetId(), node.GetDepth()))
+	}
+	assert.Equal(t, []string{"enemy1:0", "enemy2:1", "enemy3:2"}, nodes)
+	gotestAssert.DeepEqual(t, []*enemy.Relationship{
+		{FromEnemyId: "enemy1", ToEnemyId: "enemy2", Kind: enemy.Relationship_ALLY, CreatedAt: timestamppb.New(now())},
+		{FromEnemyId: "enemy3", ToEnemyId: "enemy2", Kind: enemy.Relationship_REPORTS_TO, CreatedAt: timestamppb.New(now())},
+	}, res.GetEdges(), protocmp.Transform())
+
+	res, err = es.GetEnemyNetwork(context.Background(), &enemy.GetEnemyNetworkRequest{Id: "enemy1", Depth: 5, Kinds: []enemy.Relationship_Kind{enemy.Relationship_ALLY}})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetNodes(), 2)
+
+	_, err = es.UnlinkEnemies(context.Background(), &enemy.UnlinkEnemiesRequest{FromEnemyId: "enemy1", ToEnemyId: "enemy2", Kind: enemy.Relationship_ALLY})
+	assert.NoError(t, err)
+	_, err = es.UnlinkEnemies(context.Background(), &enemy.UnlinkEnemiesRequest{FromEnemyId: "enemy1", ToEnemyId: "enemy2", Kind: enemy.Relationship_ALLY})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	res, err = es.GetEnemyNetwork(context.Background(), &enemy.GetEnemyNetworkRequest{Id: "enemy1"})
+	assert.NoError(t, err)
+	assert.Len(t, res.GetNodes(), 1)
+	assert.Empty(t, res.GetEdges())
+
+	// Both ends of a relationship have its changes in their audit log.
+	auditRes, err := es.ListAuditEvents(context.Background(), &enemy.ListAuditEventsRequest{EnemyId: "enemy2"})
+	assert.NoError(t, err)
+	var methods []string
+	for _, ev := range auditRes.GetEvents() {
+		methods = append(methods, ev.GetMethod())
+	}
+	assert.Equal(t, []string{"UnlinkEnemies", "LinkEnemies", "LinkEnemies"}, methods)
+}
+
+func TestEnemyStore_GetLeaderboard(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	for i, enmy := range []struct {
+		id     string
+		rating float32
+	}{
+		{"enemy1", 5.5},
+		{"enemy2", 9.9},
+		{"enemy3", 5.5},
+		{"enemy4", 1.1},
+		{"enemy5", 9.9},
+	} {
+		// Later enemies got their rating earlier, so they win ties.
+		lastUpdated := time.Date(2021, time.December, 10-i, 11, 59, 5, 0, time.UTC)
+		_, err = db.Exec(q, enmy.id, "Some Enemy", enmy.id+"@bar.com", enmy.rating, lastUpdated)
+		assert.NoError(t, err)
+	}
+	_, err = db.Exec("INSERT INTO enemy_rating_history (enemy_id, rating, changed_at) SELECT id, rating, last_updated FROM enemies;")
+	assert.NoError(t, err)
+	// Tagging updates enemy1 and enemy4.
+	now = func() time.Time { return time.Date(2021, time.December, 20, 11, 59, 5, 0, time.UTC) }
+	_, err = es.AddTags(context.Background(), &enemy.AddTagsRequest{Id: "enemy1", Tags: []string{"work"}})
+	assert.NoError(t, err)
+	_, err = es.AddTags(context.Background(), &enemy.AddTagsRequest{Id: "enemy4", Tags: []string{"work"}})
+	assert.NoError(t, err)
+
+	entries := func(req *enemy.GetLeaderboardRequest) []string {
+		res, err := es.GetLeaderboard(context.Background(), req)
+		assert.NoError(t, err)
+		var entries []string
+		for _, entry := range res.GetEntries() {
+			entries = append(entries, fmt.Sprintf("%s:%d:%.0f", entry.GetEnemy().GetId(), entry.GetRank(), entry.GetPercentile()))
+		}
+		return entries
+	}
+	assert.Equal(t, []string{"enemy5:1:75", "enemy2:2:75", "enemy3:3:25"}, entries(&enemy.GetLeaderboardRequest{Limit: 3}))
+	assert.Equal(t, []string{"enemy1:1:100", "enemy4:2:0"}, entries(&enemy.GetLeaderboardRequest{Tag: "work"}))
+	assert.Equal(t, []string{"enemy5:1:100", "enemy3:2:0"}, entries(&enemy.GetLeaderboardRequest{
+		StartTime: timestamppb.New(time.Date(2021, time.December, 6, 0, 0, 0, 0, time.UTC)),
+		EndTime:   timestamppb.New(time.Date(2021, time.December, 9, 0, 0, 0, 0, time.UTC)),
+	}))
+
+	res, err := es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemy4", IncludeRank: true})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), res.GetRank())
+
+	// Changes other than to the rating don't break ties differently.
+	_, err = es.AddTags(context.Background(), &enemy.AddTagsRequest{Id: "enemy3", Tags: []string{"home"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"enemy5:1:75", "enemy2:2:75", "enemy3:3:25", "enemy1:4:25"}, entries(&enemy.GetLeaderboardRequest{Limit: 4}))
+	res, err = es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemy1", IncludeRank: true})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), res.GetRank())
+}
+
+func TestEnemyStore_GetStats(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	now = func() time.Time { return time.Date(2021, time.December, 1, 14, 59, 5, 0, time.UTC) }
+	ids := []string{"enemy1", "enemy2", "enemy3", "enemy4", "enemy5"}
+	id = func() string {
+		next := ids[0]
+		ids = ids[1:]
+		return next
+	}
+	for i, rating := range []float32{1, 3, 5, 7, 9} {
+		_, _, err := es.AddEnemy(context.Background(), &enemy.AddEnemyRequest{
+			Name:   "Some Enemy",
+			Email:  "enemy" + strconv.Itoa(i+1) + "@bar.com",
+			Rating: rating,
+		})
+		assert.NoError(t, err)
+	}
+	now = func() time.Time { return time.Date(2021, time.December, 2, 14, 59, 5, 0, time.UTC) }
+	_, err = es.UpdateEnemy(context.Background(), &enemy.UpdateEnemyRequest{Id: "enemy1", Rating: 2})
+	assert.NoError(t, err)
+	_, err = es.DeleteEnemy(context.Background(), &enemy.DeleteEnemyRequest{Id: "enemy5"})
+	assert.NoError(t, err)
+
+	res, err := es.GetStats(context.Background(), &enemy.GetStatsRequest{
+		StartTime: timestamppb.New(time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)),
+		EndTime:   timestamppb.New(time.Date(2021, time.December, 3, 0, 0, 0, 0, time.UTC)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), res.GetCount())
+	assert.Equal(t, 4.25, res.GetMeanRating())
+	assert.Equal(t, 4.0, res.GetMedianRating())
+	assert.InDelta(t, 6.4, res.GetP90Rating(), 0.001)
+	assert.Equal(t, []float32{2, 4, 6, 8}, res.GetBucketBoundaries())
+	assert.Equal(t, []int32{0, 2, 1, 1, 0}, res.GetHistogram())
+	gotestAssert.DeepEqual(t, []*enemy.DailyChanges{
+		{Day: timestamppb.New(time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC)), Added: 5},
+		{Day: timestamppb.New(time.Date(2021, time.December, 2, 0, 0, 0, 0, time.UTC)), Updated: 1},
+	}, res.GetDaily(), protocmp.Transform())
+
+	_, err = es.GetStats(context.Background(), &enemy.GetStatsRequest{
+		StartTime: timestamppb.New(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+}
+
+func TestEnemyStore_Confrontations(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	_, err = db.Exec(q, "enemy1", "Some Enemy", "enemy1@bar.com", 5, time.Date(2021, time.December, 1, 11, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+
+	now = func() time.Time { return time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC) }
+	ids := []string{"confrontation1", "confrontation2", "confrontation3"}
+	id = func() string {
+		next := ids[0]
+		ids = ids[1:]
+		return next
+	}
+	res, err := es.RecordConfrontation(context.Background(), &enemy.RecordConfrontationRequest{
+		EnemyId:    "enemy1",
+		Outcome:    enemy.Confrontation_LOSS,
+		OccurredAt: timestamppb.New(time.Date(2021, time.December, 2, 11, 59, 5, 0, time.UTC)),
+		Notes:      "Lost a duel",
+	})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.Confrontation{
+		Id:           "confrontation1",
+		EnemyId:      "enemy1",
+		Outcome:      enemy.Confrontation_LOSS,
+		OccurredAt:   timestamppb.New(time.Date(2021, time.December, 2, 11, 59, 5, 0, time.UTC)),
+		Notes:        "Lost a duel",
+		RatingBefore: 5,
+		RatingAfter:  5.25,
+	}, res.GetConfrontation(), protocmp.Transform())
+	assert.Equal(t, float32(5.25), res.GetEnemy().GetRating())
+
+	_, err = es.RecordConfrontation(context.Background(), &enemy.RecordConfrontationRequest{
+		EnemyId:    "enemy1",
+		Outcome:    enemy.Confrontation_WIN,
+		OccurredAt: timestamppb.New(time.Date(2021, time.December, 3, 11, 59, 5, 0, time.UTC)),
+	})
+	assert.NoError(t, err)
+
+	// A backdated confrontation is replayed before the others, starting from
+	// the rating the enemy had before its first confrontation.
+	res, err = es.RecordConfrontation(context.Background(), &enemy.RecordConfrontationRequest{
+		EnemyId:    "enemy1",
+		Outcome:    enemy.Confrontation_LOSS,
+		OccurredAt: timestamppb.New(time.Date(2021, time.December, 1, 12, 59, 5, 0, time.UTC)),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(5), res.GetConfrontation().GetRatingBefore())
+	assert.Equal(t, float32(5.25), res.GetConfrontation().GetRatingAfter())
+	inTimeOrder := func(es *EnemyStore) float32 {
+		return es.elo(es.elo(es.elo(5, enemy.Confrontation_LOSS), enemy.Confrontation_LOSS), enemy.Confrontation_WIN)
+	}
+	assert.Equal(t, inTimeOrder(es), res.GetEnemy().GetRating())
+
+	_, err = es.RecordConfrontation(context.Background(), &enemy.RecordConfrontationRequest{EnemyId: "missing", Outcome: enemy.Confrontation_WIN})
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	// Recomputing with the same K-factor changes nothing.
+	recomputeRes, updated, err := es.RecomputeRatings(context.Background(), &enemy.RecomputeRatingsRequest{})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.RecomputeRatingsResponse{Confrontations: 3}, recomputeRes, protocmp.Transform())
+	assert.Empty(t, updated)
+
+	// A K-factor of 1 changes every step of the replay.
+	es, err = NewEnemyStore(db, WithKFactor(1))
+	assert.NoError(t, err)
+	recomputeRes, updated, err = es.RecomputeRatings(context.Background(), &enemy.RecomputeRatingsRequest{})
+	assert.NoError(t, err)
+	gotestAssert.DeepEqual(t, &enemy.RecomputeRatingsResponse{Confrontations: 3, EnemiesUpdated: 1}, recomputeRes, protocmp.Transform())
+	assert.Len(t, updated, 1)
+	assert.Equal(t, inTimeOrder(es), updated[0].GetRating())
+
+	getRes, err := es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemy1"})
+	assert.NoError(t, err)
+	assert.Equal(t, updated[0].GetRating(), getRes.GetEnemy().GetRating())
+}
+
+func TestEnemyStore_Votes(t *testing.T) {
+	pg, err := postgresdocker.New()
+	assert.NoError(t, err)
+	defer pg.Shutdown()
+
+	db, err := sql.Open("pgx", pg.ConnectionString())
+	assert.NoError(t, err)
+
+	es, err := NewEnemyStore(db)
+	assert.NoError(t, err)
+
+	q := "INSERT INTO enemies (enemy_id, full_name, email, rating, last_updated) VALUES ($1, $2, $3, $4, $5);"
+	_, err = db.Exec(q, "enemy1", "Some Enemy", "enemy1@bar.com", 5, time.Date(2021, time.December, 1, 11, 59, 5, 0, time.UTC))
+	assert.NoError(t, err)
+
+	now = func() time.Time { return time.Date(2021, time.December, 31, 14, 59, 5, 0, time.UTC) }
+	asActor := func(actor string) context.Context {
+		return WithAuditInfo(context.Background(), AuditInfo{Actor: actor})
+	}
+	for actor, rating := range map[string]float32{"alice": 1, "bob": 2, "carol": 9} {
+		_, err := es.CastVote(asActor(actor), &enemy.CastVoteRequest{EnemyId: "enemy1", Rating: rating})
+		assert.NoError(t, err)
+	}
+	// Voting again replaces the earlier vote.
+	res, err := es.CastVote(asActor("bob"), &enemy.CastVoteRequest{EnemyId: "enemy1", Rating: 3})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(13)/3, res.GetEnemy().GetVoteRating())
+	assert.Equal(t, int32(3), res.GetEnemy().GetVoteCount())
+	assert.Equal(t, float32(3), res.GetEnemy().GetMyVote())
+	// The rating set on the enemy is left alone.
+	assert.Equal(t, float32(5), res.GetEnemy().GetRating())
+
+	getRes, err := es.GetEnemy(asActor("alice"), &enemy.GetEnemyRequest{Id: "enemy1"})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(1), getRes.GetEnemy().GetMyVote())
+	getRes, err = es.GetEnemy(asActor("dave"), &enemy.GetEnemyRequest{Id: "enemy1"})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(0), getRes.GetEnemy().GetMyVote())
+	assert.Equal(t, int32(3), getRes.GetEnemy().GetVoteCount())
+
+	// Anonymous callers can't vote, and don't get anyone's vote on reads.
+	_, err = es.CastVote(context.Background(), &enemy.CastVoteRequest{EnemyId: "enemy1", Rating: 7})
+	assert.ErrorIs(t, err, ErrInvalidArgument)
+	getRes, err = es.GetEnemy(context.Background(), &enemy.GetEnemyRequest{Id: "enemy1"})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(0), getRes.GetEnemy().GetMyVote())
+	assert.Equal(t, int32(3), getRes.GetEnemy().GetVoteCount())
+
+	// Changing the aggregation applies to existing votes.
+	es, err = NewEnemyStore(db, WithVoteAggregation(Median))
+	assert.NoError(t, err)
+	getRes, err = es.GetEnemy(asActor("alice"), &enemy.GetEnemyRequest{Id: "enemy1"})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(3), getRes.GetEnemy().GetVoteRating())
+	res, err = es.CastVote(asActor("dave"), &enemy.CastVoteRequest{EnemyId: "enemy1", Rating: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, float32(3.5), res.GetEnemy().GetVoteRating())
+	assert.Equal(t, int32(4), res.GetEnemy().GetVoteCount())
+
+	_, err = es.CastVote(asActor("alice"), &enemy.CastVoteRequest{EnemyId: "missing", Rating: 1})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
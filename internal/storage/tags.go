@@ -0,0 +1,64 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+)
+
+func (e *EnemyStore) AddTags(ctx context.Context, req *enemy.AddTagsRequest) (*enemy.AddTagsResponse, error) {
+	enmy, err := e.touchEnemy(ctx, "AddTags", req.GetId(), func(q *Queries, id int32) error {
+		return q.AddEnemyTags(ctx, AddEnemyTagsParams{ID: id, Tags: textArray(req.GetTags())})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &enemy.AddTagsResponse{Enemy: enmy}, nil
+}
+
+func (e *EnemyStore) RemoveTags(ctx context.Context, req *enemy.RemoveTagsRequest) (*enemy.RemoveTagsResponse, error) {
+	enmy, err := e.touchEnemy(ctx, "RemoveTags", req.GetId(), func(q *Queries, id int32) error {
+		return q.RemoveEnemyTags(ctx, RemoveEnemyTagsParams{ID: id, Tags: textArray(req.GetTags())})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &enemy.RemoveTagsResponse{Enemy: enmy}, nil
+}
+
+func (e *EnemyStore) ListTags(ctx context.Context, req *enemy.ListTagsRequest) (*enemy.ListTagsResponse, error) {
+	rows, err := e.queries.ListTags(ctx)
+	if err != nil {
+		return nil, dbError(err)
+	}
+	res := &enemy.ListTagsResponse{}
+	for _, row := range rows {
+		res.Tags = append(res.Tags, &enemy.TagCount{Tag: row.Tag, Count: row.Count})
+	}
+	return res, nil
+}
+
+// loadTags fills in the tags of enmys, using a single query.
+func loadTags(ctx context.Context, q *Queries, enmys ...*enemy.Enemy) error {
+	if len(enmys) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(enmys))
+	byID := make(map[string][]*enemy.Enemy, len(enmys))
+	for _, enmy := range enmys {
+		if _, ok := byID[enmy.GetId()]; !ok {
+			ids = append(ids, enmy.GetId())
+		}
+		byID[enmy.GetId()] = append(byID[enmy.GetId()], enmy)
+	}
+	rows, err := q.ListEnemyTags(ctx, textArray(ids))
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for _, enmy := range byID[row.EnemyID] {
+			enmy.Tags = append(enmy.Tags, row.Tag)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/larwef/rpi-docker-test/pkg/enemy"
+	"google.golang.org/protobuf/proto"
+)
+
+// VoteAggregation is how the votes of an enemy are combined into its vote
+// rating.
+type VoteAggregation int
+
+const (
+	Mean VoteAggregation = iota
+	Median
+	// TrimmedMean is the mean of the votes without the lowest and highest
+	// tenth, so a few extreme votes can't move the rating much.
+	TrimmedMean
+)
+
+// Share of the votes at each end left out of TrimmedMean.
+const voteTrim = 0.1
+
+// WithVoteAggregation sets how votes are aggregated. The default is Mean.
+func WithVoteAggregation(a VoteAggregation) Option {
+	return func(e *EnemyStore) {
+		e.voteAggregation = a
+	}
+}
+
+// CastVote records the vote of the actor of ctx, replacing any earlier vote.
+// The count and sum of the votes of the enemy are updated from the old and new
+// vote, so the mean never needs every vote. The median and trimmed mean do, and
+// are computed from a sorted scan of the votes.
+func (e *EnemyStore) CastVote(ctx context.Context, req *enemy.CastVoteRequest) (*enemy.CastVoteResponse, error) {
+	if voter(ctx) == "" {
+		return nil, errorf(ErrInvalidArgument, "an actor is required to vote")
+	}
+	enmy, err := e.touchEnemy(ctx, "CastVote", req.GetEnemyId(), func(q *Queries, id int32) error {
+		addedVotes, addedSum := int32(1), float64(req.GetRating())
+		old, err := q.GetVoteForUpdate(ctx, GetVoteForUpdateParams{EnemyID: id, Voter: voter(ctx)})
+		switch {
+		case err == nil:
+			addedVotes = 0
+			addedSum -= float64(old)
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+		if err := q.CastVote(ctx, CastVoteParams{
+			EnemyID: id,
+			Voter:   voter(ctx),
+			Rating:  req.GetRating(),
+			CastAt:  now(),
+		}); err != nil {
+			return err
+		}
+		ratings, err := q.ListVoteRatings(ctx, id)
+		if err != nil {
+			return err
+		}
+		agg := orderStatistics(ratings)
+		agg.EnemyID = id
+		agg.AddedVotes = addedVotes
+		agg.AddedSum = addedSum
+		return q.UpdateVoteAggregate(ctx, agg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := proto.Clone(enmy).(*enemy.Enemy)
+	res.MyVote = req.GetRating()
+	return &enemy.CastVoteResponse{Enemy: res}, nil
+}
+
+// voter returns who is voting, or reading their votes, in ctx. It's empty
+// when ctx has no actor, as anonymous callers can't be told apart.
+func voter(ctx context.Context) string {
+	info, _ := AuditInfoFrom(ctx)
+	return info.Actor
+}
+
+// orderStatistics computes the median and trimmed mean of ratings, which must
+// be sorted.
+func orderStatistics(ratings []float32) UpdateVoteAggregateParams {
+	n := len(ratings)
+	var res UpdateVoteAggregateParams
+	if n == 0 {
+		return res
+	}
+	if n%2 == 1 {
+		res.Median = ratings[n/2]
+	} else {
+		res.Median = (ratings[n/2-1] + ratings[n/2]) / 2
+	}
+	trim := int(float64(n) * voteTrim)
+	var sum float64
+	for _, r := range ratings[trim : n-trim] {
+		sum += float64(r)
+	}
+	res.TrimmedMean = float32(sum / float64(n-2*trim))
+	return res
+}
+
+// loadVotes fills in the vote rating and count of enmys, and the vote of voter
+// unless it's empty, using a single query.
+func (e *EnemyStore) loadVotes(ctx context.Context, q *Queries, voter string, enmys ...*enemy.Enemy) error {
+	if len(enmys) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(enmys))
+	byID := make(map[string][]*enemy.Enemy, len(enmys))
+	for _, enmy := range enmys {
+		if _, ok := byID[enmy.GetId()]; !ok {
+			ids = append(ids, enmy.GetId())
+		}
+		byID[enmy.GetId()] = append(byID[enmy.GetId()], enmy)
+	}
+	rows, err := q.ListVoteAggregates(ctx, ListVoteAggregatesParams{Voter: voter, EnemyIds: textArray(ids)})
+	if err != nil {
+		return err
+	}
+	for _, row := range rows {
+		for _, enmy := range byID[row.EnemyID] {
+			enmy.VoteRating = e.voteRating(row)
+			enmy.VoteCount = row.VoteCount
+			if voter != "" {
+				enmy.MyVote = row.MyVote
+			}
+		}
+	}
+	return nil
+}
+
+// voteRating is the configured aggregation of the votes of row.
+func (e *EnemyStore) voteRating(row ListVoteAggregatesRow) float32 {
+	switch {
+	case row.VoteCount == 0:
+		return 0
+	case e.voteAggregation == Median:
+		return row.Median
+	case e.voteAggregation == TrimmedMean:
+		return row.TrimmedMean
+	default:
+		return float32(row.VoteSum / float64(row.VoteCount))
+	}
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderStatistics(t *testing.T) {
+	tests := []struct {
+		name    string
+		ratings []float32
+		want    UpdateVoteAggregateParams
+	}{
+		{
+			name: "Test no votes",
+			want: UpdateVoteAggregateParams{},
+		},
+		{
+			name:    "Test odd number of votes",
+			ratings: []float32{1, 2, 9},
+			want:    UpdateVoteAggregateParams{Median: 2, TrimmedMean: 4},
+		},
+		{
+			name:    "Test even number of votes",
+			ratings: []float32{1, 2, 4, 9},
+			want:    UpdateVoteAggregateParams{Median: 3, TrimmedMean: 4},
+		},
+		{
+			name:    "Test trims outliers",
+			ratings: []float32{0.5, 5, 5, 5, 5, 5, 5, 5, 5, 100},
+			want:    UpdateVoteAggregateParams{Median: 5, TrimmedMean: 5},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, orderStatistics(test.ratings))
+		})
+	}
+}
+
+func TestVoter(t *testing.T) {
+	assert.Equal(t, "", voter(context.Background()))
+	assert.Equal(t, "", voter(WithAuditInfo(context.Background(), AuditInfo{Method: "GetEnemy"})))
+	assert.Equal(t, "alice", voter(WithAuditInfo(context.Background(), AuditInfo{Actor: "alice"})))
+}
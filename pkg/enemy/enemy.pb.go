@@ -9,6 +9,7 @@ package enemy
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -21,6 +22,274 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// File formats for exporting and importing enemies.
+type Format int32
+
+const (
+	Format_FORMAT_UNSPECIFIED Format = 0
+	// Comma separated values with a header row. Import needs the name, email
+	// and rating columns, other columns are ignored.
+	Format_CSV Format = 1
+	// Newline delimited JSON, one Enemy per line.
+	Format_NDJSON Format = 2
+)
+
+// Enum value maps for Format.
+var (
+	Format_name = map[int32]string{
+		0: "FORMAT_UNSPECIFIED",
+		1: "CSV",
+		2: "NDJSON",
+	}
+	Format_value = map[string]int32{
+		"FORMAT_UNSPECIFIED": 0,
+		"CSV":                1,
+		"NDJSON":             2,
+	}
+)
+
+func (x Format) Enum() *Format {
+	p := new(Format)
+	*p = x
+	return p
+}
+
+func (x Format) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Format) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_enemy_enemy_proto_enumTypes[0].Descriptor()
+}
+
+func (Format) Type() protoreflect.EnumType {
+	return &file_pkg_enemy_enemy_proto_enumTypes[0]
+}
+
+func (x Format) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Format.Descriptor instead.
+func (Format) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{0}
+}
+
+type EnemyEvent_Type int32
+
+const (
+	EnemyEvent_TYPE_UNSPECIFIED EnemyEvent_Type = 0
+	// Enemy was added or undeleted.
+	EnemyEvent_ADDED   EnemyEvent_Type = 1
+	EnemyEvent_UPDATED EnemyEvent_Type = 2
+	// Enemy was soft deleted or purged.
+	EnemyEvent_DELETED EnemyEvent_Type = 3
+)
+
+// Enum value maps for EnemyEvent_Type.
+var (
+	EnemyEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "ADDED",
+		2: "UPDATED",
+		3: "DELETED",
+	}
+	EnemyEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"ADDED":            1,
+		"UPDATED":          2,
+		"DELETED":          3,
+	}
+)
+
+func (x EnemyEvent_Type) Enum() *EnemyEvent_Type {
+	p := new(EnemyEvent_Type)
+	*p = x
+	return p
+}
+
+func (x EnemyEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnemyEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_enemy_enemy_proto_enumTypes[1].Descriptor()
+}
+
+func (EnemyEvent_Type) Type() protoreflect.EnumType {
+	return &file_pkg_enemy_enemy_proto_enumTypes[1]
+}
+
+func (x EnemyEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnemyEvent_Type.Descriptor instead.
+func (EnemyEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{23, 0}
+}
+
+type Grievance_Severity int32
+
+const (
+	Grievance_SEVERITY_UNSPECIFIED Grievance_Severity = 0
+	Grievance_MINOR                Grievance_Severity = 1
+	Grievance_MODERATE             Grievance_Severity = 2
+	Grievance_MAJOR                Grievance_Severity = 3
+	Grievance_UNFORGIVABLE         Grievance_Severity = 4
+)
+
+// Enum value maps for Grievance_Severity.
+var (
+	Grievance_Severity_name = map[int32]string{
+		0: "SEVERITY_UNSPECIFIED",
+		1: "MINOR",
+		2: "MODERATE",
+		3: "MAJOR",
+		4: "UNFORGIVABLE",
+	}
+	Grievance_Severity_value = map[string]int32{
+		"SEVERITY_UNSPECIFIED": 0,
+		"MINOR":                1,
+		"MODERATE":             2,
+		"MAJOR":                3,
+		"UNFORGIVABLE":         4,
+	}
+)
+
+func (x Grievance_Severity) Enum() *Grievance_Severity {
+	p := new(Grievance_Severity)
+	*p = x
+	return p
+}
+
+func (x Grievance_Severity) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Grievance_Severity) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_enemy_enemy_proto_enumTypes[2].Descriptor()
+}
+
+func (Grievance_Severity) Type() protoreflect.EnumType {
+	return &file_pkg_enemy_enemy_proto_enumTypes[2]
+}
+
+func (x Grievance_Severity) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Grievance_Severity.Descriptor instead.
+func (Grievance_Severity) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{45, 0}
+}
+
+type Relationship_Kind int32
+
+const (
+	Relationship_KIND_UNSPECIFIED Relationship_Kind = 0
+	Relationship_ALLY             Relationship_Kind = 1
+	Relationship_RIVAL            Relationship_Kind = 2
+	Relationship_REPORTS_TO       Relationship_Kind = 3
+)
+
+// Enum value maps for Relationship_Kind.
+var (
+	Relationship_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "ALLY",
+		2: "RIVAL",
+		3: "REPORTS_TO",
+	}
+	Relationship_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"ALLY":             1,
+		"RIVAL":            2,
+		"REPORTS_TO":       3,
+	}
+)
+
+func (x Relationship_Kind) Enum() *Relationship_Kind {
+	p := new(Relationship_Kind)
+	*p = x
+	return p
+}
+
+func (x Relationship_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Relationship_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_enemy_enemy_proto_enumTypes[3].Descriptor()
+}
+
+func (Relationship_Kind) Type() protoreflect.EnumType {
+	return &file_pkg_enemy_enemy_proto_enumTypes[3]
+}
+
+func (x Relationship_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Relationship_Kind.Descriptor instead.
+func (Relationship_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{52, 0}
+}
+
+type Confrontation_Outcome int32
+
+const (
+	Confrontation_OUTCOME_UNSPECIFIED Confrontation_Outcome = 0
+	// We won.
+	Confrontation_WIN Confrontation_Outcome = 1
+	// We lost.
+	Confrontation_LOSS Confrontation_Outcome = 2
+	Confrontation_DRAW Confrontation_Outcome = 3
+)
+
+// Enum value maps for Confrontation_Outcome.
+var (
+	Confrontation_Outcome_name = map[int32]string{
+		0: "OUTCOME_UNSPECIFIED",
+		1: "WIN",
+		2: "LOSS",
+		3: "DRAW",
+	}
+	Confrontation_Outcome_value = map[string]int32{
+		"OUTCOME_UNSPECIFIED": 0,
+		"WIN":                 1,
+		"LOSS":                2,
+		"DRAW":                3,
+	}
+)
+
+func (x Confrontation_Outcome) Enum() *Confrontation_Outcome {
+	p := new(Confrontation_Outcome)
+	*p = x
+	return p
+}
+
+func (x Confrontation_Outcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Confrontation_Outcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_enemy_enemy_proto_enumTypes[4].Descriptor()
+}
+
+func (Confrontation_Outcome) Type() protoreflect.EnumType {
+	return &file_pkg_enemy_enemy_proto_enumTypes[4]
+}
+
+func (x Confrontation_Outcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Confrontation_Outcome.Descriptor instead.
+func (Confrontation_Outcome) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{66, 0}
+}
+
 type Enemy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -31,6 +300,23 @@ type Enemy struct {
 	Email       string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	Rating      float32                `protobuf:"fixed32,4,opt,name=rating,proto3" json:"rating,omitempty"`
 	LastUpdated *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=lastUpdated,proto3" json:"lastUpdated,omitempty"`
+	// Set when the enemy has been soft deleted.
+	DeletedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=deletedAt,proto3" json:"deletedAt,omitempty"`
+	// Opaque version of the enemy, changed on every write. Pass it to
+	// UpdateEnemy to make sure nobody else has updated the enemy since it was
+	// read.
+	Etag string `protobuf:"bytes,7,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Tags grouping the enemy, eg. "work". Tags are lowercase and sorted.
+	// They aren't versioned, so enemies read as of a past time have their
+	// current tags.
+	Tags []string `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Aggregate of the ratings users have voted for the enemy, using the
+	// aggregation the server is configured with. Unset without votes.
+	VoteRating float32 `protobuf:"fixed32,9,opt,name=voteRating,proto3" json:"voteRating,omitempty"`
+	VoteCount  int32   `protobuf:"varint,10,opt,name=voteCount,proto3" json:"voteCount,omitempty"`
+	// The rating the caller voted for, unset if they haven't voted. Only set
+	// on enemies returned to the caller by reads and CastVote, not in events.
+	MyVote float32 `protobuf:"fixed32,11,opt,name=myVote,proto3" json:"myVote,omitempty"`
 }
 
 func (x *Enemy) Reset() {
@@ -100,6 +386,48 @@ func (x *Enemy) GetLastUpdated() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Enemy) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *Enemy) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *Enemy) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Enemy) GetVoteRating() float32 {
+	if x != nil {
+		return x.VoteRating
+	}
+	return 0
+}
+
+func (x *Enemy) GetVoteCount() int32 {
+	if x != nil {
+		return x.VoteCount
+	}
+	return 0
+}
+
+func (x *Enemy) GetMyVote() float32 {
+	if x != nil {
+		return x.MyVote
+	}
+	return 0
+}
+
 type AddEnemyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -108,6 +436,11 @@ type AddEnemyRequest struct {
 	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Email  string  `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
 	Rating float32 `protobuf:"fixed32,3,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Makes retries safe. Replaying a request with the same key returns the
+	// original response instead of adding the enemy again. Can also be given
+	// as idempotency-key metadata.
+	IdempotencyKey string   `protobuf:"bytes,4,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
+	Tags           []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (x *AddEnemyRequest) Reset() {
@@ -163,6 +496,20 @@ func (x *AddEnemyRequest) GetRating() float32 {
 	return 0
 }
 
+func (x *AddEnemyRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *AddEnemyRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type AddEnemyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -216,6 +563,16 @@ type GetEnemyRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Return the enemy even if it has been soft deleted.
+	ShowDeleted bool `protobuf:"varint,2,opt,name=showDeleted,proto3" json:"showDeleted,omitempty"`
+	// Return the enemy as it was at this time instead of its current state.
+	ReadTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=readTime,proto3" json:"readTime,omitempty"`
+	// Also return the number of grievances against the enemy and the most
+	// recent one. Grievances aren't versioned, so these are always current.
+	IncludeGrievances bool `protobuf:"varint,4,opt,name=includeGrievances,proto3" json:"includeGrievances,omitempty"`
+	// Also return the current rank of the enemy, as in GetLeaderboard without
+	// any restrictions.
+	IncludeRank bool `protobuf:"varint,5,opt,name=includeRank,proto3" json:"includeRank,omitempty"`
 }
 
 func (x *GetEnemyRequest) Reset() {
@@ -257,12 +614,45 @@ func (x *GetEnemyRequest) GetId() string {
 	return ""
 }
 
+func (x *GetEnemyRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *GetEnemyRequest) GetReadTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadTime
+	}
+	return nil
+}
+
+func (x *GetEnemyRequest) GetIncludeGrievances() bool {
+	if x != nil {
+		return x.IncludeGrievances
+	}
+	return false
+}
+
+func (x *GetEnemyRequest) GetIncludeRank() bool {
+	if x != nil {
+		return x.IncludeRank
+	}
+	return false
+}
+
 type GetEnemyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Enemy *Enemy `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+	// Only set if includeGrievances is.
+	GrievanceCount  int32      `protobuf:"varint,2,opt,name=grievanceCount,proto3" json:"grievanceCount,omitempty"`
+	LatestGrievance *Grievance `protobuf:"bytes,3,opt,name=latestGrievance,proto3" json:"latestGrievance,omitempty"`
+	// Only set if includeRank is, and the enemy isn't deleted.
+	Rank int32 `protobuf:"varint,4,opt,name=rank,proto3" json:"rank,omitempty"`
 }
 
 func (x *GetEnemyResponse) Reset() {
@@ -304,6 +694,27 @@ func (x *GetEnemyResponse) GetEnemy() *Enemy {
 	return nil
 }
 
+func (x *GetEnemyResponse) GetGrievanceCount() int32 {
+	if x != nil {
+		return x.GrievanceCount
+	}
+	return 0
+}
+
+func (x *GetEnemyResponse) GetLatestGrievance() *Grievance {
+	if x != nil {
+		return x.LatestGrievance
+	}
+	return nil
+}
+
+func (x *GetEnemyResponse) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
 type UpdateEnemyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -313,6 +724,14 @@ type UpdateEnemyRequest struct {
 	Name   string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Email  string  `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 	Rating float32 `protobuf:"fixed32,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	// Fields to update, any of name, email, rating and tags. Listed fields
+	// are set even if they have zero values. Without a mask, only fields with
+	// non-zero values are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,5,opt,name=updateMask,proto3" json:"updateMask,omitempty"`
+	// If set, the update is only done if the enemy still has this etag.
+	Etag string `protobuf:"bytes,6,opt,name=etag,proto3" json:"etag,omitempty"`
+	// Replaces the tags of the enemy.
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
 func (x *UpdateEnemyRequest) Reset() {
@@ -375,6 +794,27 @@ func (x *UpdateEnemyRequest) GetRating() float32 {
 	return 0
 }
 
+func (x *UpdateEnemyRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateEnemyRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *UpdateEnemyRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
 type UpdateEnemyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -426,6 +866,28 @@ type ListEnemiesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Include soft deleted enemies in the result.
+	ShowDeleted bool `protobuf:"varint,1,opt,name=showDeleted,proto3" json:"showDeleted,omitempty"`
+	// Maximum number of enemies to return. The server picks a default if
+	// unset and caps values above its maximum.
+	PageSize int32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	// Token from a previous response's nextPageToken.
+	PageToken string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	// AIP-160 style filter over name, email, rating and lastUpdated, eg.
+	// `rating > 7 AND email = "*@corp.com"`.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Comma separated list of fields to sort by, each optionally followed by
+	// "desc", eg. "rating desc, name". One of name, email, rating and
+	// lastUpdated, which can also be spelled last_updated.
+	OrderBy string `protobuf:"bytes,5,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	// List the enemies as they were at this time instead of their current
+	// state.
+	ReadTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=readTime,proto3" json:"readTime,omitempty"`
+	// Only list enemies with at least one of these tags.
+	AnyTags []string `protobuf:"bytes,7,rep,name=anyTags,proto3" json:"anyTags,omitempty"`
+	// Only list enemies with all of these tags.
+	AllTags []string `protobuf:"bytes,8,rep,name=allTags,proto3" json:"allTags,omitempty"`
 }
 
 func (x *ListEnemiesRequest) Reset() {
@@ -460,12 +922,70 @@ func (*ListEnemiesRequest) Descriptor() ([]byte, []int) {
 	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{7}
 }
 
+func (x *ListEnemiesRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+func (x *ListEnemiesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListEnemiesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListEnemiesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ListEnemiesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ListEnemiesRequest) GetReadTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadTime
+	}
+	return nil
+}
+
+func (x *ListEnemiesRequest) GetAnyTags() []string {
+	if x != nil {
+		return x.AnyTags
+	}
+	return nil
+}
+
+func (x *ListEnemiesRequest) GetAllTags() []string {
+	if x != nil {
+		return x.AllTags
+	}
+	return nil
+}
+
 type ListEnemiesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Enemies []*Enemy `protobuf:"bytes,1,rep,name=enemies,proto3" json:"enemies,omitempty"`
+	// Token for retrieving the next page. Empty when there are no more pages.
+	NextPageToken string `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
 }
 
 func (x *ListEnemiesResponse) Reset() {
@@ -507,130 +1027,5366 @@ func (x *ListEnemiesResponse) GetEnemies() []*Enemy {
 	return nil
 }
 
-var File_pkg_enemy_enemy_proto protoreflect.FileDescriptor
-
-var file_pkg_enemy_enemy_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2f, 0x65, 0x6e, 0x65, 0x6d,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x1a, 0x1f,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x97, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x0b, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x6c, 0x61,
-	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x53, 0x0a, 0x0f, 0x41, 0x64, 0x64,
-	0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x36,
-	0x0a, 0x10, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52,
-	0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x22, 0x21, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65,
-	0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x36, 0x0a, 0x10, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
-	0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65,
-	0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x65, 0x6d,
-	0x79, 0x22, 0x66, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x39, 0x0a, 0x13, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65,
-	0x6e, 0x65, 0x6d, 0x79, 0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x65, 0x6d,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x4c, 0x69,
-	0x73, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x26, 0x0a, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79,
-	0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x32, 0x9c, 0x02, 0x0a, 0x0c, 0x45, 0x6e,
-	0x65, 0x6d, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x41, 0x64,
-	0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x16, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x41,
-	0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74,
-	0x45, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x16, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x65,
-	0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
-	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x19, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x46, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x12,
-	0x19, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x65, 0x6d,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x6e, 0x65,
-	0x6d, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x61, 0x72, 0x77, 0x65, 0x66, 0x2f, 0x72, 0x70,
-	0x69, 0x2d, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x6b,
-	0x67, 0x2f, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *ListEnemiesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
 }
 
-var (
-	file_pkg_enemy_enemy_proto_rawDescOnce sync.Once
-	file_pkg_enemy_enemy_proto_rawDescData = file_pkg_enemy_enemy_proto_rawDesc
-)
+// Soft deletes an enemy. It can be restored with UndeleteEnemy until it is
+// purged.
+type DeleteEnemyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func file_pkg_enemy_enemy_proto_rawDescGZIP() []byte {
-	file_pkg_enemy_enemy_proto_rawDescOnce.Do(func() {
-		file_pkg_enemy_enemy_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_enemy_enemy_proto_rawDescData)
-	})
-	return file_pkg_enemy_enemy_proto_rawDescData
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-var file_pkg_enemy_enemy_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
-var file_pkg_enemy_enemy_proto_goTypes = []interface{}{
-	(*Enemy)(nil),                 // 0: enemy.Enemy
-	(*AddEnemyRequest)(nil),       // 1: enemy.AddEnemyRequest
-	(*AddEnemyResponse)(nil),      // 2: enemy.AddEnemyResponse
-	(*GetEnemyRequest)(nil),       // 3: enemy.GetEnemyRequest
-	(*GetEnemyResponse)(nil),      // 4: enemy.GetEnemyResponse
-	(*UpdateEnemyRequest)(nil),    // 5: enemy.UpdateEnemyRequest
-	(*UpdateEnemyResponse)(nil),   // 6: enemy.UpdateEnemyResponse
-	(*ListEnemiesRequest)(nil),    // 7: enemy.ListEnemiesRequest
-	(*ListEnemiesResponse)(nil),   // 8: enemy.ListEnemiesResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+func (x *DeleteEnemyRequest) Reset() {
+	*x = DeleteEnemyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_pkg_enemy_enemy_proto_depIdxs = []int32{
-	9, // 0: enemy.Enemy.lastUpdated:type_name -> google.protobuf.Timestamp
-	0, // 1: enemy.AddEnemyResponse.enemy:type_name -> enemy.Enemy
-	0, // 2: enemy.GetEnemyResponse.enemy:type_name -> enemy.Enemy
-	0, // 3: enemy.UpdateEnemyResponse.enemy:type_name -> enemy.Enemy
-	0, // 4: enemy.ListEnemiesResponse.enemies:type_name -> enemy.Enemy
-	1, // 5: enemy.EnemyService.AddEnemy:input_type -> enemy.AddEnemyRequest
-	3, // 6: enemy.EnemyService.GetEnemy:input_type -> enemy.GetEnemyRequest
-	5, // 7: enemy.EnemyService.UpdateEnemy:input_type -> enemy.UpdateEnemyRequest
-	7, // 8: enemy.EnemyService.ListEnemies:input_type -> enemy.ListEnemiesRequest
-	2, // 9: enemy.EnemyService.AddEnemy:output_type -> enemy.AddEnemyResponse
-	4, // 10: enemy.EnemyService.GetEnemy:output_type -> enemy.GetEnemyResponse
-	6, // 11: enemy.EnemyService.UpdateEnemy:output_type -> enemy.UpdateEnemyResponse
-	8, // 12: enemy.EnemyService.ListEnemies:output_type -> enemy.ListEnemiesResponse
-	9, // [9:13] is the sub-list for method output_type
-	5, // [5:9] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+
+func (x *DeleteEnemyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_pkg_enemy_enemy_proto_init() }
-func file_pkg_enemy_enemy_proto_init() {
-	if File_pkg_enemy_enemy_proto != nil {
-		return
+func (*DeleteEnemyRequest) ProtoMessage() {}
+
+func (x *DeleteEnemyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_enemy_enemy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Enemy); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnemyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteEnemyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteEnemyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteEnemyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enemy *Enemy `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+}
+
+func (x *DeleteEnemyResponse) Reset() {
+	*x = DeleteEnemyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEnemyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEnemyResponse) ProtoMessage() {}
+
+func (x *DeleteEnemyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEnemyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteEnemyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteEnemyResponse) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+type UndeleteEnemyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UndeleteEnemyRequest) Reset() {
+	*x = UndeleteEnemyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteEnemyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteEnemyRequest) ProtoMessage() {}
+
+func (x *UndeleteEnemyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteEnemyRequest.ProtoReflect.Descriptor instead.
+func (*UndeleteEnemyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UndeleteEnemyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UndeleteEnemyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enemy *Enemy `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+}
+
+func (x *UndeleteEnemyResponse) Reset() {
+	*x = UndeleteEnemyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UndeleteEnemyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UndeleteEnemyResponse) ProtoMessage() {}
+
+func (x *UndeleteEnemyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UndeleteEnemyResponse.ProtoReflect.Descriptor instead.
+func (*UndeleteEnemyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *UndeleteEnemyResponse) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+// Permanently removes an enemy, whether it is soft deleted or not.
+type PurgeEnemyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *PurgeEnemyRequest) Reset() {
+	*x = PurgeEnemyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeEnemyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeEnemyRequest) ProtoMessage() {}
+
+func (x *PurgeEnemyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeEnemyRequest.ProtoReflect.Descriptor instead.
+func (*PurgeEnemyRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PurgeEnemyRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type PurgeEnemyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The enemy as it was before it was purged.
+	Enemy *Enemy `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+}
+
+func (x *PurgeEnemyResponse) Reset() {
+	*x = PurgeEnemyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurgeEnemyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeEnemyResponse) ProtoMessage() {}
+
+func (x *PurgeEnemyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeEnemyResponse.ProtoReflect.Descriptor instead.
+func (*PurgeEnemyResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PurgeEnemyResponse) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+// Lists the ratings an enemy has had, oldest first.
+type GetRatingHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Only include changes at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// Only include changes before this time.
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	PageSize  int32                  `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string                 `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *GetRatingHistoryRequest) Reset() {
+	*x = GetRatingHistoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRatingHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingHistoryRequest) ProtoMessage() {}
+
+func (x *GetRatingHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRatingHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetRatingHistoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetRatingHistoryRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetRatingHistoryRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *GetRatingHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRatingHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type RatingPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rating    float32                `protobuf:"fixed32,1,opt,name=rating,proto3" json:"rating,omitempty"`
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=changedAt,proto3" json:"changedAt,omitempty"`
+}
+
+func (x *RatingPoint) Reset() {
+	*x = RatingPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RatingPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RatingPoint) ProtoMessage() {}
+
+func (x *RatingPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RatingPoint.ProtoReflect.Descriptor instead.
+func (*RatingPoint) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RatingPoint) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *RatingPoint) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type GetRatingHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points        []*RatingPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+	NextPageToken string         `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *GetRatingHistoryResponse) Reset() {
+	*x = GetRatingHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRatingHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRatingHistoryResponse) ProtoMessage() {}
+
+func (x *GetRatingHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRatingHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRatingHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetRatingHistoryResponse) GetPoints() []*RatingPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+func (x *GetRatingHistoryResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// A change made to an enemy.
+type AuditEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnemyId string `protobuf:"bytes,1,opt,name=enemyId,proto3" json:"enemyId,omitempty"`
+	// Who made the change, taken from the actor metadata of the call.
+	Actor string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The RPC that made the change.
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// Address of the client.
+	Peer string `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer,omitempty"`
+	// The enemy before and after the change. Unset for the enemy before it was
+	// added and after it was purged. Changes to grievances and relationships
+	// leave the enemy as it was, so both are the enemy at the time.
+	Before *Enemy                 `protobuf:"bytes,5,opt,name=before,proto3" json:"before,omitempty"`
+	After  *Enemy                 `protobuf:"bytes,6,opt,name=after,proto3" json:"after,omitempty"`
+	Time   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AuditEvent) GetEnemyId() string {
+	if x != nil {
+		return x.EnemyId
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetPeer() string {
+	if x != nil {
+		return x.Peer
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetBefore() *Enemy {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetAfter() *Enemy {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Lists audit events, newest first. All filters are optional.
+type ListAuditEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnemyId string `protobuf:"bytes,1,opt,name=enemyId,proto3" json:"enemyId,omitempty"`
+	Actor   string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	// Only include events at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// Only include events before this time.
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	PageSize  int32                  `protobuf:"varint,5,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string                 `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListAuditEventsRequest) Reset() {
+	*x = ListAuditEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsRequest) ProtoMessage() {}
+
+func (x *ListAuditEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListAuditEventsRequest) GetEnemyId() string {
+	if x != nil {
+		return x.EnemyId
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *ListAuditEventsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListAuditEventsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAuditEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListAuditEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events        []*AuditEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string        `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *ListAuditEventsResponse) Reset() {
+	*x = ListAuditEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAuditEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAuditEventsResponse) ProtoMessage() {}
+
+func (x *ListAuditEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAuditEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListAuditEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListAuditEventsResponse) GetEvents() []*AuditEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListAuditEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Streams changes to enemies as they happen.
+type WatchEnemiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Only send events for enemies matching this filter, same syntax as
+	// ListEnemiesRequest.filter. Deleted enemies are matched as they were
+	// when deleted.
+	Filter string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Resume token of the last event received. If set, events since that one
+	// are sent first, so a reconnecting client misses nothing. Without it,
+	// only events from now on are sent. Only recent events are kept, in
+	// memory of the replica that sent them. A token that is too old, from
+	// before a restart or from another replica fails with OUT_OF_RANGE, and
+	// the client has to list enemies and start a new watch.
+	ResumeToken string `protobuf:"bytes,2,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+}
+
+func (x *WatchEnemiesRequest) Reset() {
+	*x = WatchEnemiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchEnemiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchEnemiesRequest) ProtoMessage() {}
+
+func (x *WatchEnemiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchEnemiesRequest.ProtoReflect.Descriptor instead.
+func (*WatchEnemiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *WatchEnemiesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *WatchEnemiesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// Like WatchEnemiesRequest, but streams the changes made through every
+// replica of the service, not just the one serving the call. Resume tokens
+// are only valid on the replica that sent them.
+type SubscribeChangesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filter      string `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	ResumeToken string `protobuf:"bytes,2,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+}
+
+func (x *SubscribeChangesRequest) Reset() {
+	*x = SubscribeChangesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeChangesRequest) ProtoMessage() {}
+
+func (x *SubscribeChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeChangesRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeChangesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SubscribeChangesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *SubscribeChangesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type EnemyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type  EnemyEvent_Type `protobuf:"varint,1,opt,name=type,proto3,enum=enemy.EnemyEvent_Type" json:"type,omitempty"`
+	Enemy *Enemy          `protobuf:"bytes,2,opt,name=enemy,proto3" json:"enemy,omitempty"`
+	// Pass to WatchEnemies to resume after this event.
+	ResumeToken string                 `protobuf:"bytes,3,opt,name=resumeToken,proto3" json:"resumeToken,omitempty"`
+	Time        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *EnemyEvent) Reset() {
+	*x = EnemyEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnemyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnemyEvent) ProtoMessage() {}
+
+func (x *EnemyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnemyEvent.ProtoReflect.Descriptor instead.
+func (*EnemyEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EnemyEvent) GetType() EnemyEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return EnemyEvent_TYPE_UNSPECIFIED
+}
+
+func (x *EnemyEvent) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+func (x *EnemyEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *EnemyEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// Adds many enemies in one call, one enemy per message.
+type BatchAddEnemiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Follows the same rules as AddEnemy, including idempotency keys. Keys
+	// have to be given in the request, as idempotency-key metadata applies
+	// to a whole call.
+	Enemy *AddEnemyRequest `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+	// Add either all enemies or none of them, failing the call if any can't
+	// be added. Only read from the first message.
+	Atomic bool `protobuf:"varint,2,opt,name=atomic,proto3" json:"atomic,omitempty"`
+}
+
+func (x *BatchAddEnemiesRequest) Reset() {
+	*x = BatchAddEnemiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchAddEnemiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddEnemiesRequest) ProtoMessage() {}
+
+func (x *BatchAddEnemiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddEnemiesRequest.ProtoReflect.Descriptor instead.
+func (*BatchAddEnemiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BatchAddEnemiesRequest) GetEnemy() *AddEnemyRequest {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+func (x *BatchAddEnemiesRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+type BatchAddEnemiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One result per request message, in the same order.
+	Results []*BatchAddEnemyResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *BatchAddEnemiesResponse) Reset() {
+	*x = BatchAddEnemiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchAddEnemiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddEnemiesResponse) ProtoMessage() {}
+
+func (x *BatchAddEnemiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddEnemiesResponse.ProtoReflect.Descriptor instead.
+func (*BatchAddEnemiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *BatchAddEnemiesResponse) GetResults() []*BatchAddEnemyResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type BatchAddEnemyResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The added enemy. Unset if it couldn't be added.
+	Enemy *Enemy `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+	// gRPC status code and message of the error adding the enemy, if any.
+	ErrorCode    int32  `protobuf:"varint,2,opt,name=errorCode,proto3" json:"errorCode,omitempty"`
+	ErrorMessage string `protobuf:"bytes,3,opt,name=errorMessage,proto3" json:"errorMessage,omitempty"`
+}
+
+func (x *BatchAddEnemyResult) Reset() {
+	*x = BatchAddEnemyResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchAddEnemyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAddEnemyResult) ProtoMessage() {}
+
+func (x *BatchAddEnemyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAddEnemyResult.ProtoReflect.Descriptor instead.
+func (*BatchAddEnemyResult) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BatchAddEnemyResult) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+func (x *BatchAddEnemyResult) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *BatchAddEnemyResult) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+type BatchGetEnemiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	// Return enemies even if they have been soft deleted.
+	ShowDeleted bool `protobuf:"varint,2,opt,name=showDeleted,proto3" json:"showDeleted,omitempty"`
+}
+
+func (x *BatchGetEnemiesRequest) Reset() {
+	*x = BatchGetEnemiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetEnemiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetEnemiesRequest) ProtoMessage() {}
+
+func (x *BatchGetEnemiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetEnemiesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetEnemiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BatchGetEnemiesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchGetEnemiesRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+type BatchGetEnemiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The enemies found, in the order of the requested ids.
+	Enemies []*Enemy `protobuf:"bytes,1,rep,name=enemies,proto3" json:"enemies,omitempty"`
+	// The requested ids that weren't found.
+	MissingIds []string `protobuf:"bytes,2,rep,name=missingIds,proto3" json:"missingIds,omitempty"`
+}
+
+func (x *BatchGetEnemiesResponse) Reset() {
+	*x = BatchGetEnemiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchGetEnemiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetEnemiesResponse) ProtoMessage() {}
+
+func (x *BatchGetEnemiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetEnemiesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetEnemiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BatchGetEnemiesResponse) GetEnemies() []*Enemy {
+	if x != nil {
+		return x.Enemies
+	}
+	return nil
+}
+
+func (x *BatchGetEnemiesResponse) GetMissingIds() []string {
+	if x != nil {
+		return x.MissingIds
+	}
+	return nil
+}
+
+// Updates many enemies at once. Either all updates are made or none of them.
+type BatchUpdateEnemiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Requests []*UpdateEnemyRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
+}
+
+func (x *BatchUpdateEnemiesRequest) Reset() {
+	*x = BatchUpdateEnemiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateEnemiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateEnemiesRequest) ProtoMessage() {}
+
+func (x *BatchUpdateEnemiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateEnemiesRequest.ProtoReflect.Descriptor instead.
+func (*BatchUpdateEnemiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BatchUpdateEnemiesRequest) GetRequests() []*UpdateEnemyRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BatchUpdateEnemiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The updated enemies, in the order of the requests.
+	Enemies []*Enemy `protobuf:"bytes,1,rep,name=enemies,proto3" json:"enemies,omitempty"`
+}
+
+func (x *BatchUpdateEnemiesResponse) Reset() {
+	*x = BatchUpdateEnemiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchUpdateEnemiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchUpdateEnemiesResponse) ProtoMessage() {}
+
+func (x *BatchUpdateEnemiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchUpdateEnemiesResponse.ProtoReflect.Descriptor instead.
+func (*BatchUpdateEnemiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *BatchUpdateEnemiesResponse) GetEnemies() []*Enemy {
+	if x != nil {
+		return x.Enemies
+	}
+	return nil
+}
+
+// A row of a bulk load, one per message. Rows are upserted by email, so a row
+// with the email of an existing enemy updates that enemy. If several rows have
+// the same email, the last one wins.
+type BulkLoadEnemiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Email  string  `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Rating float32 `protobuf:"fixed32,3,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *BulkLoadEnemiesRequest) Reset() {
+	*x = BulkLoadEnemiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkLoadEnemiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkLoadEnemiesRequest) ProtoMessage() {}
+
+func (x *BulkLoadEnemiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkLoadEnemiesRequest.ProtoReflect.Descriptor instead.
+func (*BulkLoadEnemiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *BulkLoadEnemiesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BulkLoadEnemiesRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *BulkLoadEnemiesRequest) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type BulkLoadEnemiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inserted int32 `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Updated  int32 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	Rejected int32 `protobuf:"varint,3,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	// Why each of the rejected rows was rejected, ordered by line.
+	RejectedRows []*RejectedRow `protobuf:"bytes,4,rep,name=rejectedRows,proto3" json:"rejectedRows,omitempty"`
+}
+
+func (x *BulkLoadEnemiesResponse) Reset() {
+	*x = BulkLoadEnemiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BulkLoadEnemiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BulkLoadEnemiesResponse) ProtoMessage() {}
+
+func (x *BulkLoadEnemiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BulkLoadEnemiesResponse.ProtoReflect.Descriptor instead.
+func (*BulkLoadEnemiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *BulkLoadEnemiesResponse) GetInserted() int32 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *BulkLoadEnemiesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *BulkLoadEnemiesResponse) GetRejected() int32 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *BulkLoadEnemiesResponse) GetRejectedRows() []*RejectedRow {
+	if x != nil {
+		return x.RejectedRows
+	}
+	return nil
+}
+
+type RejectedRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Position of the row in the load, starting at 1.
+	Line   int32  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *RejectedRow) Reset() {
+	*x = RejectedRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RejectedRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedRow) ProtoMessage() {}
+
+func (x *RejectedRow) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedRow.ProtoReflect.Descriptor instead.
+func (*RejectedRow) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RejectedRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *RejectedRow) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Exports enemies, as listed by ListEnemies.
+type ExportEnemiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Defaults to CSV.
+	Format  Format `protobuf:"varint,1,opt,name=format,proto3,enum=enemy.Format" json:"format,omitempty"`
+	Filter  string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	OrderBy string `protobuf:"bytes,3,opt,name=orderBy,proto3" json:"orderBy,omitempty"`
+	// Deleted enemies are exported with their deletedAt set, and are skipped
+	// when the file is imported.
+	ShowDeleted bool `protobuf:"varint,4,opt,name=showDeleted,proto3" json:"showDeleted,omitempty"`
+}
+
+func (x *ExportEnemiesRequest) Reset() {
+	*x = ExportEnemiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportEnemiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEnemiesRequest) ProtoMessage() {}
+
+func (x *ExportEnemiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEnemiesRequest.ProtoReflect.Descriptor instead.
+func (*ExportEnemiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ExportEnemiesRequest) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportEnemiesRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *ExportEnemiesRequest) GetOrderBy() string {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ""
+}
+
+func (x *ExportEnemiesRequest) GetShowDeleted() bool {
+	if x != nil {
+		return x.ShowDeleted
+	}
+	return false
+}
+
+// A chunk of the exported file. Concatenate the chunks to get the file.
+type ExportEnemiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ExportEnemiesResponse) Reset() {
+	*x = ExportEnemiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportEnemiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportEnemiesResponse) ProtoMessage() {}
+
+func (x *ExportEnemiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportEnemiesResponse.ProtoReflect.Descriptor instead.
+func (*ExportEnemiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ExportEnemiesResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// A chunk of the file to import. The other fields are only read from the
+// first message.
+type ImportEnemiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Defaults to CSV.
+	Format Format `protobuf:"varint,2,opt,name=format,proto3,enum=enemy.Format" json:"format,omitempty"`
+	// Update enemies with the email of an imported row instead of rejecting
+	// the row.
+	Upsert bool `protobuf:"varint,3,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	// Check the file without importing anything. The rows are imported in a
+	// transaction that is rolled back, so rows that conflict with other
+	// enemies are rejected as they would be by the import.
+	DryRun bool `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+}
+
+func (x *ImportEnemiesRequest) Reset() {
+	*x = ImportEnemiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportEnemiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEnemiesRequest) ProtoMessage() {}
+
+func (x *ImportEnemiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEnemiesRequest.ProtoReflect.Descriptor instead.
+func (*ImportEnemiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ImportEnemiesRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ImportEnemiesRequest) GetFormat() Format {
+	if x != nil {
+		return x.Format
+	}
+	return Format_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportEnemiesRequest) GetUpsert() bool {
+	if x != nil {
+		return x.Upsert
+	}
+	return false
+}
+
+func (x *ImportEnemiesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportEnemiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Inserted int32 `protobuf:"varint,1,opt,name=inserted,proto3" json:"inserted,omitempty"`
+	Updated  int32 `protobuf:"varint,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	// Number of rows that passed validation.
+	Valid int32 `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Rows that weren't imported, by line number in the file.
+	RejectedRows []*RejectedRow `protobuf:"bytes,4,rep,name=rejectedRows,proto3" json:"rejectedRows,omitempty"`
+	// Number of rows of deleted enemies, which aren't imported.
+	Skipped int32 `protobuf:"varint,5,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *ImportEnemiesResponse) Reset() {
+	*x = ImportEnemiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportEnemiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportEnemiesResponse) ProtoMessage() {}
+
+func (x *ImportEnemiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportEnemiesResponse.ProtoReflect.Descriptor instead.
+func (*ImportEnemiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ImportEnemiesResponse) GetInserted() int32 {
+	if x != nil {
+		return x.Inserted
+	}
+	return 0
+}
+
+func (x *ImportEnemiesResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportEnemiesResponse) GetValid() int32 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *ImportEnemiesResponse) GetRejectedRows() []*RejectedRow {
+	if x != nil {
+		return x.RejectedRows
+	}
+	return nil
+}
+
+func (x *ImportEnemiesResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type AddTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *AddTagsRequest) Reset() {
+	*x = AddTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTagsRequest) ProtoMessage() {}
+
+func (x *AddTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTagsRequest.ProtoReflect.Descriptor instead.
+func (*AddTagsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AddTagsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AddTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type AddTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enemy *Enemy `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+}
+
+func (x *AddTagsResponse) Reset() {
+	*x = AddTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTagsResponse) ProtoMessage() {}
+
+func (x *AddTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTagsResponse.ProtoReflect.Descriptor instead.
+func (*AddTagsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddTagsResponse) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+type RemoveTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *RemoveTagsRequest) Reset() {
+	*x = RemoveTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTagsRequest) ProtoMessage() {}
+
+func (x *RemoveTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTagsRequest.ProtoReflect.Descriptor instead.
+func (*RemoveTagsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RemoveTagsRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RemoveTagsRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type RemoveTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enemy *Enemy `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+}
+
+func (x *RemoveTagsResponse) Reset() {
+	*x = RemoveTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveTagsResponse) ProtoMessage() {}
+
+func (x *RemoveTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveTagsResponse.ProtoReflect.Descriptor instead.
+func (*RemoveTagsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RemoveTagsResponse) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+// Lists the tags in use, most used first.
+type ListTagsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListTagsRequest) Reset() {
+	*x = ListTagsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsRequest) ProtoMessage() {}
+
+func (x *ListTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsRequest.ProtoReflect.Descriptor instead.
+func (*ListTagsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{42}
+}
+
+type ListTagsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tags []*TagCount `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+}
+
+func (x *ListTagsResponse) Reset() {
+	*x = ListTagsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTagsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTagsResponse) ProtoMessage() {}
+
+func (x *ListTagsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTagsResponse.ProtoReflect.Descriptor instead.
+func (*ListTagsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListTagsResponse) GetTags() []*TagCount {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+type TagCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Number of enemies with the tag, not counting soft deleted ones.
+	Count int32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *TagCount) Reset() {
+	*x = TagCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TagCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagCount) ProtoMessage() {}
+
+func (x *TagCount) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagCount.ProtoReflect.Descriptor instead.
+func (*TagCount) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TagCount) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *TagCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// Something an enemy did to deserve their rating.
+type Grievance struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EnemyId    string                 `protobuf:"bytes,2,opt,name=enemyId,proto3" json:"enemyId,omitempty"`
+	Text       string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	Severity   Grievance_Severity     `protobuf:"varint,4,opt,name=severity,proto3,enum=enemy.Grievance_Severity" json:"severity,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	// Taken from the actor metadata of the call adding the grievance.
+	CreatedBy string                 `protobuf:"bytes,6,opt,name=createdBy,proto3" json:"createdBy,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *Grievance) Reset() {
+	*x = Grievance{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Grievance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Grievance) ProtoMessage() {}
+
+func (x *Grievance) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Grievance.ProtoReflect.Descriptor instead.
+func (*Grievance) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *Grievance) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Grievance) GetEnemyId() string {
+	if x != nil {
+		return x.EnemyId
+	}
+	return ""
+}
+
+func (x *Grievance) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Grievance) GetSeverity() Grievance_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Grievance_SEVERITY_UNSPECIFIED
+}
+
+func (x *Grievance) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *Grievance) GetCreatedBy() string {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return ""
+}
+
+func (x *Grievance) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type AddGrievanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnemyId  string             `protobuf:"bytes,1,opt,name=enemyId,proto3" json:"enemyId,omitempty"`
+	Text     string             `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Severity Grievance_Severity `protobuf:"varint,3,opt,name=severity,proto3,enum=enemy.Grievance_Severity" json:"severity,omitempty"`
+	// When it happened. Defaults to now.
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+}
+
+func (x *AddGrievanceRequest) Reset() {
+	*x = AddGrievanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddGrievanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGrievanceRequest) ProtoMessage() {}
+
+func (x *AddGrievanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGrievanceRequest.ProtoReflect.Descriptor instead.
+func (*AddGrievanceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AddGrievanceRequest) GetEnemyId() string {
+	if x != nil {
+		return x.EnemyId
+	}
+	return ""
+}
+
+func (x *AddGrievanceRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AddGrievanceRequest) GetSeverity() Grievance_Severity {
+	if x != nil {
+		return x.Severity
+	}
+	return Grievance_SEVERITY_UNSPECIFIED
+}
+
+func (x *AddGrievanceRequest) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type AddGrievanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grievance *Grievance `protobuf:"bytes,1,opt,name=grievance,proto3" json:"grievance,omitempty"`
+}
+
+func (x *AddGrievanceResponse) Reset() {
+	*x = AddGrievanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddGrievanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddGrievanceResponse) ProtoMessage() {}
+
+func (x *AddGrievanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddGrievanceResponse.ProtoReflect.Descriptor instead.
+func (*AddGrievanceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AddGrievanceResponse) GetGrievance() *Grievance {
+	if x != nil {
+		return x.Grievance
+	}
+	return nil
+}
+
+// Lists the grievances against an enemy, most recent first.
+type ListGrievancesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnemyId   string `protobuf:"bytes,1,opt,name=enemyId,proto3" json:"enemyId,omitempty"`
+	PageSize  int32  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	PageToken string `protobuf:"bytes,3,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+}
+
+func (x *ListGrievancesRequest) Reset() {
+	*x = ListGrievancesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGrievancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGrievancesRequest) ProtoMessage() {}
+
+func (x *ListGrievancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGrievancesRequest.ProtoReflect.Descriptor instead.
+func (*ListGrievancesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListGrievancesRequest) GetEnemyId() string {
+	if x != nil {
+		return x.EnemyId
+	}
+	return ""
+}
+
+func (x *ListGrievancesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListGrievancesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListGrievancesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grievances    []*Grievance `protobuf:"bytes,1,rep,name=grievances,proto3" json:"grievances,omitempty"`
+	NextPageToken string       `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+}
+
+func (x *ListGrievancesResponse) Reset() {
+	*x = ListGrievancesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGrievancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGrievancesResponse) ProtoMessage() {}
+
+func (x *ListGrievancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGrievancesResponse.ProtoReflect.Descriptor instead.
+func (*ListGrievancesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListGrievancesResponse) GetGrievances() []*Grievance {
+	if x != nil {
+		return x.Grievances
+	}
+	return nil
+}
+
+func (x *ListGrievancesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type DeleteGrievanceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnemyId string `protobuf:"bytes,1,opt,name=enemyId,proto3" json:"enemyId,omitempty"`
+	Id      string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteGrievanceRequest) Reset() {
+	*x = DeleteGrievanceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGrievanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGrievanceRequest) ProtoMessage() {}
+
+func (x *DeleteGrievanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGrievanceRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGrievanceRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteGrievanceRequest) GetEnemyId() string {
+	if x != nil {
+		return x.EnemyId
+	}
+	return ""
+}
+
+func (x *DeleteGrievanceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteGrievanceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Grievance *Grievance `protobuf:"bytes,1,opt,name=grievance,proto3" json:"grievance,omitempty"`
+}
+
+func (x *DeleteGrievanceResponse) Reset() {
+	*x = DeleteGrievanceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteGrievanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGrievanceResponse) ProtoMessage() {}
+
+func (x *DeleteGrievanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGrievanceResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGrievanceResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *DeleteGrievanceResponse) GetGrievance() *Grievance {
+	if x != nil {
+		return x.Grievance
+	}
+	return nil
+}
+
+// How one enemy relates to another. Relationships are directed, eg. the
+// from enemy reports to the to enemy, but an enemy may be linked to another
+// with more than one kind.
+type Relationship struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEnemyId string                 `protobuf:"bytes,1,opt,name=fromEnemyId,proto3" json:"fromEnemyId,omitempty"`
+	ToEnemyId   string                 `protobuf:"bytes,2,opt,name=toEnemyId,proto3" json:"toEnemyId,omitempty"`
+	Kind        Relationship_Kind      `protobuf:"varint,3,opt,name=kind,proto3,enum=enemy.Relationship_Kind" json:"kind,omitempty"`
+	CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+}
+
+func (x *Relationship) Reset() {
+	*x = Relationship{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Relationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Relationship) ProtoMessage() {}
+
+func (x *Relationship) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Relationship.ProtoReflect.Descriptor instead.
+func (*Relationship) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Relationship) GetFromEnemyId() string {
+	if x != nil {
+		return x.FromEnemyId
+	}
+	return ""
+}
+
+func (x *Relationship) GetToEnemyId() string {
+	if x != nil {
+		return x.ToEnemyId
+	}
+	return ""
+}
+
+func (x *Relationship) GetKind() Relationship_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Relationship_KIND_UNSPECIFIED
+}
+
+func (x *Relationship) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type LinkEnemiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEnemyId string            `protobuf:"bytes,1,opt,name=fromEnemyId,proto3" json:"fromEnemyId,omitempty"`
+	ToEnemyId   string            `protobuf:"bytes,2,opt,name=toEnemyId,proto3" json:"toEnemyId,omitempty"`
+	Kind        Relationship_Kind `protobuf:"varint,3,opt,name=kind,proto3,enum=enemy.Relationship_Kind" json:"kind,omitempty"`
+}
+
+func (x *LinkEnemiesRequest) Reset() {
+	*x = LinkEnemiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkEnemiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkEnemiesRequest) ProtoMessage() {}
+
+func (x *LinkEnemiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkEnemiesRequest.ProtoReflect.Descriptor instead.
+func (*LinkEnemiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LinkEnemiesRequest) GetFromEnemyId() string {
+	if x != nil {
+		return x.FromEnemyId
+	}
+	return ""
+}
+
+func (x *LinkEnemiesRequest) GetToEnemyId() string {
+	if x != nil {
+		return x.ToEnemyId
+	}
+	return ""
+}
+
+func (x *LinkEnemiesRequest) GetKind() Relationship_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Relationship_KIND_UNSPECIFIED
+}
+
+type LinkEnemiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Relationship *Relationship `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+}
+
+func (x *LinkEnemiesResponse) Reset() {
+	*x = LinkEnemiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkEnemiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkEnemiesResponse) ProtoMessage() {}
+
+func (x *LinkEnemiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkEnemiesResponse.ProtoReflect.Descriptor instead.
+func (*LinkEnemiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LinkEnemiesResponse) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+type UnlinkEnemiesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromEnemyId string            `protobuf:"bytes,1,opt,name=fromEnemyId,proto3" json:"fromEnemyId,omitempty"`
+	ToEnemyId   string            `protobuf:"bytes,2,opt,name=toEnemyId,proto3" json:"toEnemyId,omitempty"`
+	Kind        Relationship_Kind `protobuf:"varint,3,opt,name=kind,proto3,enum=enemy.Relationship_Kind" json:"kind,omitempty"`
+}
+
+func (x *UnlinkEnemiesRequest) Reset() {
+	*x = UnlinkEnemiesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlinkEnemiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkEnemiesRequest) ProtoMessage() {}
+
+func (x *UnlinkEnemiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkEnemiesRequest.ProtoReflect.Descriptor instead.
+func (*UnlinkEnemiesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *UnlinkEnemiesRequest) GetFromEnemyId() string {
+	if x != nil {
+		return x.FromEnemyId
+	}
+	return ""
+}
+
+func (x *UnlinkEnemiesRequest) GetToEnemyId() string {
+	if x != nil {
+		return x.ToEnemyId
+	}
+	return ""
+}
+
+func (x *UnlinkEnemiesRequest) GetKind() Relationship_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return Relationship_KIND_UNSPECIFIED
+}
+
+type UnlinkEnemiesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Relationship *Relationship `protobuf:"bytes,1,opt,name=relationship,proto3" json:"relationship,omitempty"`
+}
+
+func (x *UnlinkEnemiesResponse) Reset() {
+	*x = UnlinkEnemiesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlinkEnemiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlinkEnemiesResponse) ProtoMessage() {}
+
+func (x *UnlinkEnemiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlinkEnemiesResponse.ProtoReflect.Descriptor instead.
+func (*UnlinkEnemiesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *UnlinkEnemiesResponse) GetRelationship() *Relationship {
+	if x != nil {
+		return x.Relationship
+	}
+	return nil
+}
+
+// Returns the enemies reachable from an enemy, following relationships in
+// either direction, and the relationships between them. Deleted enemies are
+// left out.
+type GetEnemyNetworkRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// How many relationships away from the enemy to go. Defaults to 1.
+	Depth int32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Only follow relationships of these kinds. All kinds if empty.
+	Kinds []Relationship_Kind `protobuf:"varint,3,rep,packed,name=kinds,proto3,enum=enemy.Relationship_Kind" json:"kinds,omitempty"`
+}
+
+func (x *GetEnemyNetworkRequest) Reset() {
+	*x = GetEnemyNetworkRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnemyNetworkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnemyNetworkRequest) ProtoMessage() {}
+
+func (x *GetEnemyNetworkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnemyNetworkRequest.ProtoReflect.Descriptor instead.
+func (*GetEnemyNetworkRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetEnemyNetworkRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetEnemyNetworkRequest) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *GetEnemyNetworkRequest) GetKinds() []Relationship_Kind {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+type NetworkNode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enemy *Enemy `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+	// Number of relationships between this enemy and the one asked for.
+	Depth int32 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (x *NetworkNode) Reset() {
+	*x = NetworkNode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetworkNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetworkNode) ProtoMessage() {}
+
+func (x *NetworkNode) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetworkNode.ProtoReflect.Descriptor instead.
+func (*NetworkNode) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *NetworkNode) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+func (x *NetworkNode) GetDepth() int32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+type GetEnemyNetworkResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ordered by depth, starting with the enemy asked for.
+	Nodes []*NetworkNode  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges []*Relationship `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+}
+
+func (x *GetEnemyNetworkResponse) Reset() {
+	*x = GetEnemyNetworkResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEnemyNetworkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEnemyNetworkResponse) ProtoMessage() {}
+
+func (x *GetEnemyNetworkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEnemyNetworkResponse.ProtoReflect.Descriptor instead.
+func (*GetEnemyNetworkResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetEnemyNetworkResponse) GetNodes() []*NetworkNode {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *GetEnemyNetworkResponse) GetEdges() []*Relationship {
+	if x != nil {
+		return x.Edges
+	}
+	return nil
+}
+
+// Returns the highest rated enemies, not counting deleted ones. The optional
+// restrictions narrow the pool the enemies are ranked in.
+type GetLeaderboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of enemies to return. Defaults to 10.
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Only rank enemies with this tag.
+	Tag string `protobuf:"bytes,2,opt,name=tag,proto3" json:"tag,omitempty"`
+	// Only rank enemies last updated at or after this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	// Only rank enemies last updated before this time.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (x *GetLeaderboardRequest) Reset() {
+	*x = GetLeaderboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetLeaderboardRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetLeaderboardRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetLeaderboardRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type LeaderboardEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enemy *Enemy `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+	// Rank by rating, starting at 1. Ties are broken by when the enemies got
+	// their rating, earliest first, and then by when they were added. Other
+	// changes, like tags or votes, don't affect the rank.
+	Rank int32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Percentage of the pool rated lower than the enemy, from 0 to 100.
+	Percentile float64 `protobuf:"fixed64,3,opt,name=percentile,proto3" json:"percentile,omitempty"`
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LeaderboardEntry) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetPercentile() float64 {
+	if x != nil {
+		return x.Percentile
+	}
+	return 0
+}
+
+type GetLeaderboardResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetLeaderboardResponse) Reset() {
+	*x = GetLeaderboardResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Summarizes the enemies. Deleted enemies are only counted in the daily
+// changes.
+type GetStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Ascending, finite boundaries of the rating histogram buckets. Defaults
+	// to 2, 4, 6 and 8.
+	BucketBoundaries []float32 `protobuf:"fixed32,1,rep,packed,name=bucketBoundaries,proto3" json:"bucketBoundaries,omitempty"`
+	// Time window of the daily changes, at most 366 days. The end defaults to
+	// now, and the start to 30 days before the end. The start must be before
+	// the end once the defaults are applied.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+}
+
+func (x *GetStatsRequest) Reset() {
+	*x = GetStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsRequest) ProtoMessage() {}
+
+func (x *GetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetStatsRequest) GetBucketBoundaries() []float32 {
+	if x != nil {
+		return x.BucketBoundaries
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetStatsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type DailyChanges struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start of the day, in UTC.
+	Day *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=day,proto3" json:"day,omitempty"`
+	// Number of enemies added.
+	Added int32 `protobuf:"varint,2,opt,name=added,proto3" json:"added,omitempty"`
+	// Number of updates to enemies, not counting deletes. Anything that bumps
+	// the version of an enemy counts, including tag changes, votes and
+	// confrontations.
+	Updated int32 `protobuf:"varint,3,opt,name=updated,proto3" json:"updated,omitempty"`
+}
+
+func (x *DailyChanges) Reset() {
+	*x = DailyChanges{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DailyChanges) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DailyChanges) ProtoMessage() {}
+
+func (x *DailyChanges) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DailyChanges.ProtoReflect.Descriptor instead.
+func (*DailyChanges) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *DailyChanges) GetDay() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Day
+	}
+	return nil
+}
+
+func (x *DailyChanges) GetAdded() int32 {
+	if x != nil {
+		return x.Added
+	}
+	return 0
+}
+
+func (x *DailyChanges) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+type GetStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count        int32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	MeanRating   float64 `protobuf:"fixed64,2,opt,name=meanRating,proto3" json:"meanRating,omitempty"`
+	MedianRating float64 `protobuf:"fixed64,3,opt,name=medianRating,proto3" json:"medianRating,omitempty"`
+	P90Rating    float64 `protobuf:"fixed64,4,opt,name=p90Rating,proto3" json:"p90Rating,omitempty"`
+	// The boundaries the histogram was computed with.
+	BucketBoundaries []float32 `protobuf:"fixed32,5,rep,packed,name=bucketBoundaries,proto3" json:"bucketBoundaries,omitempty"`
+	// Number of enemies per bucket. The first bucket counts the ratings below
+	// the first boundary, bucket i those at or above boundary i-1 and below
+	// boundary i, and the last bucket those at or above the last boundary.
+	Histogram []int32 `protobuf:"varint,6,rep,packed,name=histogram,proto3" json:"histogram,omitempty"`
+	// One entry per day of the time window, oldest first.
+	Daily []*DailyChanges `protobuf:"bytes,7,rep,name=daily,proto3" json:"daily,omitempty"`
+}
+
+func (x *GetStatsResponse) Reset() {
+	*x = GetStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStatsResponse) ProtoMessage() {}
+
+func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetStatsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetMeanRating() float64 {
+	if x != nil {
+		return x.MeanRating
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetMedianRating() float64 {
+	if x != nil {
+		return x.MedianRating
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetP90Rating() float64 {
+	if x != nil {
+		return x.P90Rating
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetBucketBoundaries() []float32 {
+	if x != nil {
+		return x.BucketBoundaries
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetHistogram() []int32 {
+	if x != nil {
+		return x.Histogram
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetDaily() []*DailyChanges {
+	if x != nil {
+		return x.Daily
+	}
+	return nil
+}
+
+// A run-in with an enemy. Ratings work like Elo ratings, with the enemy
+// playing against us: an enemy's rating goes up when we lose, and down when we
+// win.
+type Confrontation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EnemyId    string                 `protobuf:"bytes,2,opt,name=enemyId,proto3" json:"enemyId,omitempty"`
+	Outcome    Confrontation_Outcome  `protobuf:"varint,3,opt,name=outcome,proto3,enum=enemy.Confrontation_Outcome" json:"outcome,omitempty"`
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	Notes      string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Rating of the enemy before and after the confrontation.
+	RatingBefore float32 `protobuf:"fixed32,6,opt,name=ratingBefore,proto3" json:"ratingBefore,omitempty"`
+	RatingAfter  float32 `protobuf:"fixed32,7,opt,name=ratingAfter,proto3" json:"ratingAfter,omitempty"`
+}
+
+func (x *Confrontation) Reset() {
+	*x = Confrontation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Confrontation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Confrontation) ProtoMessage() {}
+
+func (x *Confrontation) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Confrontation.ProtoReflect.Descriptor instead.
+func (*Confrontation) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *Confrontation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Confrontation) GetEnemyId() string {
+	if x != nil {
+		return x.EnemyId
+	}
+	return ""
+}
+
+func (x *Confrontation) GetOutcome() Confrontation_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Confrontation_OUTCOME_UNSPECIFIED
+}
+
+func (x *Confrontation) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *Confrontation) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Confrontation) GetRatingBefore() float32 {
+	if x != nil {
+		return x.RatingBefore
+	}
+	return 0
+}
+
+func (x *Confrontation) GetRatingAfter() float32 {
+	if x != nil {
+		return x.RatingAfter
+	}
+	return 0
+}
+
+// Records a confrontation with an enemy that isn't deleted, and updates the
+// rating of the enemy.
+type RecordConfrontationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnemyId string                `protobuf:"bytes,1,opt,name=enemyId,proto3" json:"enemyId,omitempty"`
+	Outcome Confrontation_Outcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=enemy.Confrontation_Outcome" json:"outcome,omitempty"`
+	// When it happened. Defaults to now. The rating is updated from its
+	// current value, unless the confrontation happened before others of the
+	// enemy. Then they are all replayed like RecomputeRatings does.
+	OccurredAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+	Notes      string                 `protobuf:"bytes,4,opt,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *RecordConfrontationRequest) Reset() {
+	*x = RecordConfrontationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordConfrontationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordConfrontationRequest) ProtoMessage() {}
+
+func (x *RecordConfrontationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordConfrontationRequest.ProtoReflect.Descriptor instead.
+func (*RecordConfrontationRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *RecordConfrontationRequest) GetEnemyId() string {
+	if x != nil {
+		return x.EnemyId
+	}
+	return ""
+}
+
+func (x *RecordConfrontationRequest) GetOutcome() Confrontation_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return Confrontation_OUTCOME_UNSPECIFIED
+}
+
+func (x *RecordConfrontationRequest) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+func (x *RecordConfrontationRequest) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+type RecordConfrontationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Confrontation *Confrontation `protobuf:"bytes,1,opt,name=confrontation,proto3" json:"confrontation,omitempty"`
+	Enemy         *Enemy         `protobuf:"bytes,2,opt,name=enemy,proto3" json:"enemy,omitempty"`
+}
+
+func (x *RecordConfrontationResponse) Reset() {
+	*x = RecordConfrontationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecordConfrontationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordConfrontationResponse) ProtoMessage() {}
+
+func (x *RecordConfrontationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordConfrontationResponse.ProtoReflect.Descriptor instead.
+func (*RecordConfrontationResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RecordConfrontationResponse) GetConfrontation() *Confrontation {
+	if x != nil {
+		return x.Confrontation
+	}
+	return nil
+}
+
+func (x *RecordConfrontationResponse) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+// Rebuilds the rating of every enemy with confrontations by replaying them in
+// the order they happened, starting from the rating the enemy had when its
+// first confrontation was recorded. Confrontations that happened at the same
+// time are replayed in the order they were recorded. Ratings set by hand since
+// then are overwritten. Meant for admins, eg. after changing the K-factor.
+// Callers must send the admin token the server is configured with as
+// "authorization: Bearer <token>" metadata, and get UNAUTHENTICATED without it
+// or PERMISSION_DENIED with a wrong one.
+type RecomputeRatingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecomputeRatingsRequest) Reset() {
+	*x = RecomputeRatingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecomputeRatingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeRatingsRequest) ProtoMessage() {}
+
+func (x *RecomputeRatingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeRatingsRequest.ProtoReflect.Descriptor instead.
+func (*RecomputeRatingsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{69}
+}
+
+type RecomputeRatingsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of confrontations replayed.
+	Confrontations int32 `protobuf:"varint,1,opt,name=confrontations,proto3" json:"confrontations,omitempty"`
+	// Number of enemies whose rating changed.
+	EnemiesUpdated int32 `protobuf:"varint,2,opt,name=enemiesUpdated,proto3" json:"enemiesUpdated,omitempty"`
+}
+
+func (x *RecomputeRatingsResponse) Reset() {
+	*x = RecomputeRatingsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecomputeRatingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecomputeRatingsResponse) ProtoMessage() {}
+
+func (x *RecomputeRatingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecomputeRatingsResponse.ProtoReflect.Descriptor instead.
+func (*RecomputeRatingsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *RecomputeRatingsResponse) GetConfrontations() int32 {
+	if x != nil {
+		return x.Confrontations
+	}
+	return 0
+}
+
+func (x *RecomputeRatingsResponse) GetEnemiesUpdated() int32 {
+	if x != nil {
+		return x.EnemiesUpdated
+	}
+	return 0
+}
+
+// Casts the vote of the caller, given by the actor metadata, for an enemy that
+// isn't deleted. Voting again replaces the earlier vote.
+type CastVoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnemyId string `protobuf:"bytes,1,opt,name=enemyId,proto3" json:"enemyId,omitempty"`
+	// Same rule as the rating of an enemy: finite and greater than 0.
+	Rating float32 `protobuf:"fixed32,2,opt,name=rating,proto3" json:"rating,omitempty"`
+}
+
+func (x *CastVoteRequest) Reset() {
+	*x = CastVoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CastVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastVoteRequest) ProtoMessage() {}
+
+func (x *CastVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastVoteRequest.ProtoReflect.Descriptor instead.
+func (*CastVoteRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CastVoteRequest) GetEnemyId() string {
+	if x != nil {
+		return x.EnemyId
+	}
+	return ""
+}
+
+func (x *CastVoteRequest) GetRating() float32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+type CastVoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enemy *Enemy `protobuf:"bytes,1,opt,name=enemy,proto3" json:"enemy,omitempty"`
+}
+
+func (x *CastVoteResponse) Reset() {
+	*x = CastVoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_enemy_enemy_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CastVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CastVoteResponse) ProtoMessage() {}
+
+func (x *CastVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_enemy_enemy_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CastVoteResponse.ProtoReflect.Descriptor instead.
+func (*CastVoteResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_enemy_enemy_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CastVoteResponse) GetEnemy() *Enemy {
+	if x != nil {
+		return x.Enemy
+	}
+	return nil
+}
+
+var File_pkg_enemy_enemy_proto protoreflect.FileDescriptor
+
+var file_pkg_enemy_enemy_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2f, 0x65, 0x6e, 0x65, 0x6d,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x1a, 0x20,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xcf, 0x02, 0x0a, 0x05, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a,
+	0x0b, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x0a, 0x76, 0x6f, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x76, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x79, 0x56, 0x6f, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x6d, 0x79, 0x56,
+	0x6f, 0x74, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65,
+	0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x36, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65,
+	0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79,
+	0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x22, 0xcb, 0x01,
+	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x47,
+	0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x22, 0xae, 0x01, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x67, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x67, 0x72,
+	0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x0f,
+	0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x72,
+	0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x47,
+	0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0xca, 0x01, 0x0a,
+	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x3a, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x73, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x73,
+	0x6b, 0x12, 0x12, 0x0a, 0x04, 0x65, 0x74, 0x61, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x65, 0x74, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x39, 0x0a, 0x13, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x22, 0x8e, 0x02, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x65,
+	0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73,
+	0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x36, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x79, 0x54, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x6e, 0x79, 0x54, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x6c, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c,
+	0x6c, 0x54, 0x61, 0x67, 0x73, 0x22, 0x63, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x65,
+	0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07,
+	0x65, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x65,
+	0x6d, 0x69, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x24, 0x0a, 0x12, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x22, 0x39, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45,
+	0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x22, 0x26, 0x0a, 0x14, 0x55,
+	0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x22, 0x3b, 0x0a, 0x15, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45,
+	0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e,
+	0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79,
+	0x22, 0x23, 0x0a, 0x11, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x38, 0x0a, 0x12, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e,
+	0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65,
+	0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x22,
+	0xd3, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5f, 0x0a, 0x0b, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x41, 0x74, 0x22, 0x6c, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74,
+	0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x24,
+	0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe2, 0x01, 0x0a, 0x0a, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x65, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x12,
+	0x24, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65,
+	0x6d, 0x79, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xf2, 0x01, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34,
+	0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6a,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x65, 0x6e, 0x65, 0x6d,
+	0x79, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x4f, 0x0a, 0x13, 0x57, 0x61,
+	0x74, 0x63, 0x68, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x53, 0x0a, 0x17, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x20,
+	0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0xf1, 0x01, 0x0a, 0x0a, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65,
+	0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x12,
+	0x20, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x22, 0x41, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x50,
+	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54,
+	0x45, 0x44, 0x10, 0x03, 0x22, 0x5e, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64,
+	0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c,
+	0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x74, 0x6f, 0x6d, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x74,
+	0x6f, 0x6d, 0x69, 0x63, 0x22, 0x4f, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64,
+	0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x34, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64,
+	0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x7b, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64,
+	0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x22, 0x0a, 0x05,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e,
+	0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22,
+	0x0a, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x4c, 0x0a, 0x16, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x22, 0x61, 0x0a, 0x17, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x65,
+	0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d,
+	0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x49, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x19, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x44, 0x0a, 0x1a, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45,
+	0x6e, 0x65, 0x6d, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x22, 0x5a, 0x0a,
+	0x16, 0x42, 0x75, 0x6c, 0x6b, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x22, 0xa3, 0x01, 0x0a, 0x17, 0x42, 0x75,
+	0x6c, 0x6b, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x73, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72,
+	0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f,
+	0x77, 0x52, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x22,
+	0x39, 0x0a, 0x0b, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x12, 0x12,
+	0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6c, 0x69,
+	0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x91, 0x01, 0x0a, 0x14, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x79, 0x12, 0x20, 0x0a, 0x0b,
+	0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0x2b,
+	0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x81, 0x01, 0x0a, 0x14,
+	0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79,
+	0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x75, 0x70, 0x73, 0x65, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x22,
+	0xb5, 0x01, 0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6e, 0x73,
+	0x65, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x52, 0x6f, 0x77, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x6e,
+	0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x52,
+	0x0c, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0x34, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x35, 0x0a,
+	0x0f, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x05, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x22, 0x37, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61,
+	0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x38, 0x0a,
+	0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79,
+	0x52, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x22, 0x11, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x54, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x04, 0x74,
+	0x61, 0x67, 0x73, 0x22, 0x32, 0x0a, 0x08, 0x54, 0x61, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf0, 0x02, 0x0a, 0x09, 0x47, 0x72, 0x69, 0x65,
+	0x76, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x72,
+	0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79,
+	0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x6f, 0x63,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x42, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5a,
+	0x0a, 0x08, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x14, 0x53, 0x45,
+	0x56, 0x45, 0x52, 0x49, 0x54, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x49, 0x4e, 0x4f, 0x52, 0x10, 0x01, 0x12,
+	0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x09, 0x0a,
+	0x05, 0x4d, 0x41, 0x4a, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x46, 0x4f,
+	0x52, 0x47, 0x49, 0x56, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x04, 0x22, 0xb6, 0x01, 0x0a, 0x13, 0x41,
+	0x64, 0x64, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74,
+	0x12, 0x35, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x72, 0x69, 0x65, 0x76,
+	0x61, 0x6e, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x52, 0x08, 0x73,
+	0x65, 0x76, 0x65, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3a, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x22, 0x46, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x67,
+	0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x09, 0x67, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x6b, 0x0a, 0x15, 0x4c,
+	0x69, 0x73, 0x74, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x70, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x67, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47,
+	0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x67, 0x72, 0x69, 0x65, 0x76, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x42, 0x0a, 0x16, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x49,
+	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x09, 0x67, 0x72, 0x69,
+	0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x09,
+	0x67, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x22, 0xf9, 0x01, 0x0a, 0x0c, 0x52, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72,
+	0x6f, 0x6d, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x6f, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x6f, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79,
+	0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x4b, 0x69,
+	0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x41, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x08, 0x0a, 0x04, 0x41, 0x4c, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x49,
+	0x56, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x53,
+	0x5f, 0x54, 0x4f, 0x10, 0x03, 0x22, 0x82, 0x01, 0x0a, 0x12, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x6e,
+	0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x66, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x6f, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x6f, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x65,
+	0x6d, 0x79, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x2e,
+	0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x22, 0x4e, 0x0a, 0x13, 0x4c, 0x69,
+	0x6e, 0x6b, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0c, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x84, 0x01, 0x0a, 0x14, 0x55,
+	0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x6e, 0x65, 0x6d, 0x79,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x45, 0x6e,
+	0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x45, 0x6e, 0x65, 0x6d, 0x79,
+	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x45, 0x6e, 0x65, 0x6d,
+	0x79, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x22, 0x50, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x65, 0x6d, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0c, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x0c, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x22, 0x6e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65,
+	0x70, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x18, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x05, 0x6b, 0x69,
+	0x6e, 0x64, 0x73, 0x22, 0x47, 0x0a, 0x0b, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52,
+	0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64, 0x65, 0x70, 0x74, 0x68, 0x22, 0x6e, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x12, 0x29, 0x0a, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x05, 0x65, 0x64, 0x67, 0x65, 0x73, 0x22, 0xaf, 0x01, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x38,
+	0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6a,
+	0x0a, 0x10, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52,
+	0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x22, 0x4b, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x02, 0x52, 0x10, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x6f, 0x75,
+	0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6c, 0x0a, 0x0c, 0x44, 0x61, 0x69, 0x6c, 0x79,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x61, 0x64, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xff, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6d, 0x65, 0x61, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x6e, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x39, 0x30, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x70, 0x39, 0x30, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x6f, 0x75, 0x6e,
+	0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x02, 0x52, 0x10, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x05, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x29, 0x0a, 0x05,
+	0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6e,
+	0x65, 0x6d, 0x79, 0x2e, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x22, 0xca, 0x02, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66,
+	0x72, 0x6f, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x65,
+	0x6d, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d,
+	0x79, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f,
+	0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6f,
+	0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x0c, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x66, 0x74, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x66,
+	0x74, 0x65, 0x72, 0x22, 0x3f, 0x0a, 0x07, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x17,
+	0x0a, 0x13, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x57, 0x49, 0x4e, 0x10, 0x01,
+	0x12, 0x08, 0x0a, 0x04, 0x4c, 0x4f, 0x53, 0x53, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x44, 0x52,
+	0x41, 0x57, 0x10, 0x03, 0x22, 0xc0, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x43,
+	0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x36, 0x0a,
+	0x07, 0x6f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x1b, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52,
+	0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x6a, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x65,
+	0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x43, 0x0a,
+	0x0f, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x22, 0x36, 0x0a, 0x10, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e,
+	0x65, 0x6d, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2a, 0x35, 0x0a, 0x06, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03,
+	0x43, 0x53, 0x56, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x44, 0x4a, 0x53, 0x4f, 0x4e, 0x10,
+	0x02, 0x32, 0xcd, 0x12, 0x0a, 0x0c, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x16,
+	0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x41,
+	0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x3d, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x16, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x65,
+	0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x46, 0x0a, 0x0b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x12,
+	0x19, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x6e, 0x65,
+	0x6d, 0x79, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74,
+	0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x46, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x12,
+	0x19, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e,
+	0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x6e, 0x65,
+	0x6d, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x55, 0x6e, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x65, 0x6d,
+	0x79, 0x2e, 0x55, 0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x55,
+	0x6e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0a, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45,
+	0x6e, 0x65, 0x6d, 0x79, 0x12, 0x18, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x50, 0x75, 0x72,
+	0x67, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19,
+	0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x50, 0x75, 0x72, 0x67, 0x65, 0x45, 0x6e, 0x65, 0x6d,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x1e, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x45,
+	0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x57,
+	0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x49, 0x0a, 0x10, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x54, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64,
+	0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x41, 0x64, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x52, 0x0a, 0x0f, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65,
+	0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b,
+	0x0a, 0x12, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x65,
+	0x6d, 0x69, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x0f, 0x42,
+	0x75, 0x6c, 0x6b, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x12, 0x1d,
+	0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x4c, 0x6f, 0x61, 0x64, 0x45,
+	0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x42, 0x75, 0x6c, 0x6b, 0x4c, 0x6f, 0x61, 0x64, 0x45, 0x6e,
+	0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
+	0x01, 0x12, 0x4e, 0x0a, 0x0d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69,
+	0x65, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
+	0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x4e, 0x0a, 0x0d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69,
+	0x65, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x45, 0x6e,
+	0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28,
+	0x01, 0x12, 0x3a, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x15, 0x2e, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x54,
+	0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a,
+	0x0a, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x18, 0x2e, 0x65, 0x6e,
+	0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x16,
+	0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x61, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x49, 0x0a, 0x0c, 0x41, 0x64, 0x64, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1a, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x69,
+	0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e,
+	0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x1c,
+	0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x69, 0x65, 0x76,
+	0x61, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65,
+	0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a,
+	0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47,
+	0x72, 0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1e, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x47, 0x72,
+	0x69, 0x65, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x0b, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73,
+	0x12, 0x19, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x65,
+	0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x65, 0x6e,
+	0x65, 0x6d, 0x79, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0d, 0x55, 0x6e, 0x6c,
+	0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x65,
+	0x6d, 0x79, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e,
+	0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x45, 0x6e, 0x65, 0x6d, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x45, 0x6e,
+	0x65, 0x6d, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x65,
+	0x6d, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x65, 0x6e, 0x65, 0x6d,
+	0x79, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x65, 0x6d, 0x79, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x1c, 0x2e,
+	0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x65, 0x6e,
+	0x65, 0x6d, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x13, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x10, 0x52,
+	0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x1e, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x3d, 0x0a, 0x08, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x16,
+	0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x43, 0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x65, 0x6e, 0x65, 0x6d, 0x79, 0x2e, 0x43,
+	0x61, 0x73, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x42, 0x2d, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6c, 0x61, 0x72, 0x77, 0x65, 0x66, 0x2f, 0x72, 0x70, 0x69, 0x2d, 0x64, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x65, 0x6e, 0x65, 0x6d, 0x79,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_enemy_enemy_proto_rawDescOnce sync.Once
+	file_pkg_enemy_enemy_proto_rawDescData = file_pkg_enemy_enemy_proto_rawDesc
+)
+
+func file_pkg_enemy_enemy_proto_rawDescGZIP() []byte {
+	file_pkg_enemy_enemy_proto_rawDescOnce.Do(func() {
+		file_pkg_enemy_enemy_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_enemy_enemy_proto_rawDescData)
+	})
+	return file_pkg_enemy_enemy_proto_rawDescData
+}
+
+var file_pkg_enemy_enemy_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_pkg_enemy_enemy_proto_msgTypes = make([]protoimpl.MessageInfo, 73)
+var file_pkg_enemy_enemy_proto_goTypes = []interface{}{
+	(Format)(0),                         // 0: enemy.Format
+	(EnemyEvent_Type)(0),                // 1: enemy.EnemyEvent.Type
+	(Grievance_Severity)(0),             // 2: enemy.Grievance.Severity
+	(Relationship_Kind)(0),              // 3: enemy.Relationship.Kind
+	(Confrontation_Outcome)(0),          // 4: enemy.Confrontation.Outcome
+	(*Enemy)(nil),                       // 5: enemy.Enemy
+	(*AddEnemyRequest)(nil),             // 6: enemy.AddEnemyRequest
+	(*AddEnemyResponse)(nil),            // 7: enemy.AddEnemyResponse
+	(*GetEnemyRequest)(nil),             // 8: enemy.GetEnemyRequest
+	(*GetEnemyResponse)(nil),            // 9: enemy.GetEnemyResponse
+	(*UpdateEnemyRequest)(nil),          // 10: enemy.UpdateEnemyRequest
+	(*UpdateEnemyResponse)(nil),         // 11: enemy.UpdateEnemyResponse
+	(*ListEnemiesRequest)(nil),          // 12: enemy.ListEnemiesRequest
+	(*ListEnemiesResponse)(nil),         // 13: enemy.ListEnemiesResponse
+	(*DeleteEnemyRequest)(nil),          // 14: enemy.DeleteEnemyRequest
+	(*DeleteEnemyResponse)(nil),         // 15: enemy.DeleteEnemyResponse
+	(*UndeleteEnemyRequest)(nil),        // 16: enemy.UndeleteEnemyRequest
+	(*UndeleteEnemyResponse)(nil),       // 17: enemy.UndeleteEnemyResponse
+	(*PurgeEnemyRequest)(nil),           // 18: enemy.PurgeEnemyRequest
+	(*PurgeEnemyResponse)(nil),          // 19: enemy.PurgeEnemyResponse
+	(*GetRatingHistoryRequest)(nil),     // 20: enemy.GetRatingHistoryRequest
+	(*RatingPoint)(nil),                 // 21: enemy.RatingPoint
+	(*GetRatingHistoryResponse)(nil),    // 22: enemy.GetRatingHistoryResponse
+	(*AuditEvent)(nil),                  // 23: enemy.AuditEvent
+	(*ListAuditEventsRequest)(nil),      // 24: enemy.ListAuditEventsRequest
+	(*ListAuditEventsResponse)(nil),     // 25: enemy.ListAuditEventsResponse
+	(*WatchEnemiesRequest)(nil),         // 26: enemy.WatchEnemiesRequest
+	(*SubscribeChangesRequest)(nil),     // 27: enemy.SubscribeChangesRequest
+	(*EnemyEvent)(nil),                  // 28: enemy.EnemyEvent
+	(*BatchAddEnemiesRequest)(nil),      // 29: enemy.BatchAddEnemiesRequest
+	(*BatchAddEnemiesResponse)(nil),     // 30: enemy.BatchAddEnemiesResponse
+	(*BatchAddEnemyResult)(nil),         // 31: enemy.BatchAddEnemyResult
+	(*BatchGetEnemiesRequest)(nil),      // 32: enemy.BatchGetEnemiesRequest
+	(*BatchGetEnemiesResponse)(nil),     // 33: enemy.BatchGetEnemiesResponse
+	(*BatchUpdateEnemiesRequest)(nil),   // 34: enemy.BatchUpdateEnemiesRequest
+	(*BatchUpdateEnemiesResponse)(nil),  // 35: enemy.BatchUpdateEnemiesResponse
+	(*BulkLoadEnemiesRequest)(nil),      // 36: enemy.BulkLoadEnemiesRequest
+	(*BulkLoadEnemiesResponse)(nil),     // 37: enemy.BulkLoadEnemiesResponse
+	(*RejectedRow)(nil),                 // 38: enemy.RejectedRow
+	(*ExportEnemiesRequest)(nil),        // 39: enemy.ExportEnemiesRequest
+	(*ExportEnemiesResponse)(nil),       // 40: enemy.ExportEnemiesResponse
+	(*ImportEnemiesRequest)(nil),        // 41: enemy.ImportEnemiesRequest
+	(*ImportEnemiesResponse)(nil),       // 42: enemy.ImportEnemiesResponse
+	(*AddTagsRequest)(nil),              // 43: enemy.AddTagsRequest
+	(*AddTagsResponse)(nil),             // 44: enemy.AddTagsResponse
+	(*RemoveTagsRequest)(nil),           // 45: enemy.RemoveTagsRequest
+	(*RemoveTagsResponse)(nil),          // 46: enemy.RemoveTagsResponse
+	(*ListTagsRequest)(nil),             // 47: enemy.ListTagsRequest
+	(*ListTagsResponse)(nil),            // 48: enemy.ListTagsResponse
+	(*TagCount)(nil),                    // 49: enemy.TagCount
+	(*Grievance)(nil),                   // 50: enemy.Grievance
+	(*AddGrievanceRequest)(nil),         // 51: enemy.AddGrievanceRequest
+	(*AddGrievanceResponse)(nil),        // 52: enemy.AddGrievanceResponse
+	(*ListGrievancesRequest)(nil),       // 53: enemy.ListGrievancesRequest
+	(*ListGrievancesResponse)(nil),      // 54: enemy.ListGrievancesResponse
+	(*DeleteGrievanceRequest)(nil),      // 55: enemy.DeleteGrievanceRequest
+	(*DeleteGrievanceResponse)(nil),     // 56: enemy.DeleteGrievanceResponse
+	(*Relationship)(nil),                // 57: enemy.Relationship
+	(*LinkEnemiesRequest)(nil),          // 58: enemy.LinkEnemiesRequest
+	(*LinkEnemiesResponse)(nil),         // 59: enemy.LinkEnemiesResponse
+	(*UnlinkEnemiesRequest)(nil),        // 60: enemy.UnlinkEnemiesRequest
+	(*UnlinkEnemiesResponse)(nil),       // 61: enemy.UnlinkEnemiesResponse
+	(*GetEnemyNetworkRequest)(nil),      // 62: enemy.GetEnemyNetworkRequest
+	(*NetworkNode)(nil),                 // 63: enemy.NetworkNode
+	(*GetEnemyNetworkResponse)(nil),     // 64: enemy.GetEnemyNetworkResponse
+	(*GetLeaderboardRequest)(nil),       // 65: enemy.GetLeaderboardRequest
+	(*LeaderboardEntry)(nil),            // 66: enemy.LeaderboardEntry
+	(*GetLeaderboardResponse)(nil),      // 67: enemy.GetLeaderboardResponse
+	(*GetStatsRequest)(nil),             // 68: enemy.GetStatsRequest
+	(*DailyChanges)(nil),                // 69: enemy.DailyChanges
+	(*GetStatsResponse)(nil),            // 70: enemy.GetStatsResponse
+	(*Confrontation)(nil),               // 71: enemy.Confrontation
+	(*RecordConfrontationRequest)(nil),  // 72: enemy.RecordConfrontationRequest
+	(*RecordConfrontationResponse)(nil), // 73: enemy.RecordConfrontationResponse
+	(*RecomputeRatingsRequest)(nil),     // 74: enemy.RecomputeRatingsRequest
+	(*RecomputeRatingsResponse)(nil),    // 75: enemy.RecomputeRatingsResponse
+	(*CastVoteRequest)(nil),             // 76: enemy.CastVoteRequest
+	(*CastVoteResponse)(nil),            // 77: enemy.CastVoteResponse
+	(*timestamppb.Timestamp)(nil),       // 78: google.protobuf.Timestamp
+	(*fieldmaskpb.FieldMask)(nil),       // 79: google.protobuf.FieldMask
+}
+var file_pkg_enemy_enemy_proto_depIdxs = []int32{
+	78,  // 0: enemy.Enemy.lastUpdated:type_name -> google.protobuf.Timestamp
+	78,  // 1: enemy.Enemy.deletedAt:type_name -> google.protobuf.Timestamp
+	5,   // 2: enemy.AddEnemyResponse.enemy:type_name -> enemy.Enemy
+	78,  // 3: enemy.GetEnemyRequest.readTime:type_name -> google.protobuf.Timestamp
+	5,   // 4: enemy.GetEnemyResponse.enemy:type_name -> enemy.Enemy
+	50,  // 5: enemy.GetEnemyResponse.latestGrievance:type_name -> enemy.Grievance
+	79,  // 6: enemy.UpdateEnemyRequest.updateMask:type_name -> google.protobuf.FieldMask
+	5,   // 7: enemy.UpdateEnemyResponse.enemy:type_name -> enemy.Enemy
+	78,  // 8: enemy.ListEnemiesRequest.readTime:type_name -> google.protobuf.Timestamp
+	5,   // 9: enemy.ListEnemiesResponse.enemies:type_name -> enemy.Enemy
+	5,   // 10: enemy.DeleteEnemyResponse.enemy:type_name -> enemy.Enemy
+	5,   // 11: enemy.UndeleteEnemyResponse.enemy:type_name -> enemy.Enemy
+	5,   // 12: enemy.PurgeEnemyResponse.enemy:type_name -> enemy.Enemy
+	78,  // 13: enemy.GetRatingHistoryRequest.startTime:type_name -> google.protobuf.Timestamp
+	78,  // 14: enemy.GetRatingHistoryRequest.endTime:type_name -> google.protobuf.Timestamp
+	78,  // 15: enemy.RatingPoint.changedAt:type_name -> google.protobuf.Timestamp
+	21,  // 16: enemy.GetRatingHistoryResponse.points:type_name -> enemy.RatingPoint
+	5,   // 17: enemy.AuditEvent.before:type_name -> enemy.Enemy
+	5,   // 18: enemy.AuditEvent.after:type_name -> enemy.Enemy
+	78,  // 19: enemy.AuditEvent.time:type_name -> google.protobuf.Timestamp
+	78,  // 20: enemy.ListAuditEventsRequest.startTime:type_name -> google.protobuf.Timestamp
+	78,  // 21: enemy.ListAuditEventsRequest.endTime:type_name -> google.protobuf.Timestamp
+	23,  // 22: enemy.ListAuditEventsResponse.events:type_name -> enemy.AuditEvent
+	1,   // 23: enemy.EnemyEvent.type:type_name -> enemy.EnemyEvent.Type
+	5,   // 24: enemy.EnemyEvent.enemy:type_name -> enemy.Enemy
+	78,  // 25: enemy.EnemyEvent.time:type_name -> google.protobuf.Timestamp
+	6,   // 26: enemy.BatchAddEnemiesRequest.enemy:type_name -> enemy.AddEnemyRequest
+	31,  // 27: enemy.BatchAddEnemiesResponse.results:type_name -> enemy.BatchAddEnemyResult
+	5,   // 28: enemy.BatchAddEnemyResult.enemy:type_name -> enemy.Enemy
+	5,   // 29: enemy.BatchGetEnemiesResponse.enemies:type_name -> enemy.Enemy
+	10,  // 30: enemy.BatchUpdateEnemiesRequest.requests:type_name -> enemy.UpdateEnemyRequest
+	5,   // 31: enemy.BatchUpdateEnemiesResponse.enemies:type_name -> enemy.Enemy
+	38,  // 32: enemy.BulkLoadEnemiesResponse.rejectedRows:type_name -> enemy.RejectedRow
+	0,   // 33: enemy.ExportEnemiesRequest.format:type_name -> enemy.Format
+	0,   // 34: enemy.ImportEnemiesRequest.format:type_name -> enemy.Format
+	38,  // 35: enemy.ImportEnemiesResponse.rejectedRows:type_name -> enemy.RejectedRow
+	5,   // 36: enemy.AddTagsResponse.enemy:type_name -> enemy.Enemy
+	5,   // 37: enemy.RemoveTagsResponse.enemy:type_name -> enemy.Enemy
+	49,  // 38: enemy.ListTagsResponse.tags:type_name -> enemy.TagCount
+	2,   // 39: enemy.Grievance.severity:type_name -> enemy.Grievance.Severity
+	78,  // 40: enemy.Grievance.occurredAt:type_name -> google.protobuf.Timestamp
+	78,  // 41: enemy.Grievance.createdAt:type_name -> google.protobuf.Timestamp
+	2,   // 42: enemy.AddGrievanceRequest.severity:type_name -> enemy.Grievance.Severity
+	78,  // 43: enemy.AddGrievanceRequest.occurredAt:type_name -> google.protobuf.Timestamp
+	50,  // 44: enemy.AddGrievanceResponse.grievance:type_name -> enemy.Grievance
+	50,  // 45: enemy.ListGrievancesResponse.grievances:type_name -> enemy.Grievance
+	50,  // 46: enemy.DeleteGrievanceResponse.grievance:type_name -> enemy.Grievance
+	3,   // 47: enemy.Relationship.kind:type_name -> enemy.Relationship.Kind
+	78,  // 48: enemy.Relationship.createdAt:type_name -> google.protobuf.Timestamp
+	3,   // 49: enemy.LinkEnemiesRequest.kind:type_name -> enemy.Relationship.Kind
+	57,  // 50: enemy.LinkEnemiesResponse.relationship:type_name -> enemy.Relationship
+	3,   // 51: enemy.UnlinkEnemiesRequest.kind:type_name -> enemy.Relationship.Kind
+	57,  // 52: enemy.UnlinkEnemiesResponse.relationship:type_name -> enemy.Relationship
+	3,   // 53: enemy.GetEnemyNetworkRequest.kinds:type_name -> enemy.Relationship.Kind
+	5,   // 54: enemy.NetworkNode.enemy:type_name -> enemy.Enemy
+	63,  // 55: enemy.GetEnemyNetworkResponse.nodes:type_name -> enemy.NetworkNode
+	57,  // 56: enemy.GetEnemyNetworkResponse.edges:type_name -> enemy.Relationship
+	78,  // 57: enemy.GetLeaderboardRequest.startTime:type_name -> google.protobuf.Timestamp
+	78,  // 58: enemy.GetLeaderboardRequest.endTime:type_name -> google.protobuf.Timestamp
+	5,   // 59: enemy.LeaderboardEntry.enemy:type_name -> enemy.Enemy
+	66,  // 60: enemy.GetLeaderboardResponse.entries:type_name -> enemy.LeaderboardEntry
+	78,  // 61: enemy.GetStatsRequest.startTime:type_name -> google.protobuf.Timestamp
+	78,  // 62: enemy.GetStatsRequest.endTime:type_name -> google.protobuf.Timestamp
+	78,  // 63: enemy.DailyChanges.day:type_name -> google.protobuf.Timestamp
+	69,  // 64: enemy.GetStatsResponse.daily:type_name -> enemy.DailyChanges
+	4,   // 65: enemy.Confrontation.outcome:type_name -> enemy.Confrontation.Outcome
+	78,  // 66: enemy.Confrontation.occurredAt:type_name -> google.protobuf.Timestamp
+	4,   // 67: enemy.RecordConfrontationRequest.outcome:type_name -> enemy.Confrontation.Outcome
+	78,  // 68: enemy.RecordConfrontationRequest.occurredAt:type_name -> google.protobuf.Timestamp
+	71,  // 69: enemy.RecordConfrontationResponse.confrontation:type_name -> enemy.Confrontation
+	5,   // 70: enemy.RecordConfrontationResponse.enemy:type_name -> enemy.Enemy
+	5,   // 71: enemy.CastVoteResponse.enemy:type_name -> enemy.Enemy
+	6,   // 72: enemy.EnemyService.AddEnemy:input_type -> enemy.AddEnemyRequest
+	8,   // 73: enemy.EnemyService.GetEnemy:input_type -> enemy.GetEnemyRequest
+	10,  // 74: enemy.EnemyService.UpdateEnemy:input_type -> enemy.UpdateEnemyRequest
+	12,  // 75: enemy.EnemyService.ListEnemies:input_type -> enemy.ListEnemiesRequest
+	14,  // 76: enemy.EnemyService.DeleteEnemy:input_type -> enemy.DeleteEnemyRequest
+	16,  // 77: enemy.EnemyService.UndeleteEnemy:input_type -> enemy.UndeleteEnemyRequest
+	18,  // 78: enemy.EnemyService.PurgeEnemy:input_type -> enemy.PurgeEnemyRequest
+	20,  // 79: enemy.EnemyService.GetRatingHistory:input_type -> enemy.GetRatingHistoryRequest
+	24,  // 80: enemy.EnemyService.ListAuditEvents:input_type -> enemy.ListAuditEventsRequest
+	26,  // 81: enemy.EnemyService.WatchEnemies:input_type -> enemy.WatchEnemiesRequest
+	27,  // 82: enemy.EnemyService.SubscribeChanges:input_type -> enemy.SubscribeChangesRequest
+	29,  // 83: enemy.EnemyService.BatchAddEnemies:input_type -> enemy.BatchAddEnemiesRequest
+	32,  // 84: enemy.EnemyService.BatchGetEnemies:input_type -> enemy.BatchGetEnemiesRequest
+	34,  // 85: enemy.EnemyService.BatchUpdateEnemies:input_type -> enemy.BatchUpdateEnemiesRequest
+	36,  // 86: enemy.EnemyService.BulkLoadEnemies:input_type -> enemy.BulkLoadEnemiesRequest
+	39,  // 87: enemy.EnemyService.ExportEnemies:input_type -> enemy.ExportEnemiesRequest
+	41,  // 88: enemy.EnemyService.ImportEnemies:input_type -> enemy.ImportEnemiesRequest
+	43,  // 89: enemy.EnemyService.AddTags:input_type -> enemy.AddTagsRequest
+	45,  // 90: enemy.EnemyService.RemoveTags:input_type -> enemy.RemoveTagsRequest
+	47,  // 91: enemy.EnemyService.ListTags:input_type -> enemy.ListTagsRequest
+	51,  // 92: enemy.EnemyService.AddGrievance:input_type -> enemy.AddGrievanceRequest
+	53,  // 93: enemy.EnemyService.ListGrievances:input_type -> enemy.ListGrievancesRequest
+	55,  // 94: enemy.EnemyService.DeleteGrievance:input_type -> enemy.DeleteGrievanceRequest
+	58,  // 95: enemy.EnemyService.LinkEnemies:input_type -> enemy.LinkEnemiesRequest
+	60,  // 96: enemy.EnemyService.UnlinkEnemies:input_type -> enemy.UnlinkEnemiesRequest
+	62,  // 97: enemy.EnemyService.GetEnemyNetwork:input_type -> enemy.GetEnemyNetworkRequest
+	65,  // 98: enemy.EnemyService.GetLeaderboard:input_type -> enemy.GetLeaderboardRequest
+	68,  // 99: enemy.EnemyService.GetStats:input_type -> enemy.GetStatsRequest
+	72,  // 100: enemy.EnemyService.RecordConfrontation:input_type -> enemy.RecordConfrontationRequest
+	74,  // 101: enemy.EnemyService.RecomputeRatings:input_type -> enemy.RecomputeRatingsRequest
+	76,  // 102: enemy.EnemyService.CastVote:input_type -> enemy.CastVoteRequest
+	7,   // 103: enemy.EnemyService.AddEnemy:output_type -> enemy.AddEnemyResponse
+	9,   // 104: enemy.EnemyService.GetEnemy:output_type -> enemy.GetEnemyResponse
+	11,  // 105: enemy.EnemyService.UpdateEnemy:output_type -> enemy.UpdateEnemyResponse
+	13,  // 106: enemy.EnemyService.ListEnemies:output_type -> enemy.ListEnemiesResponse
+	15,  // 107: enemy.EnemyService.DeleteEnemy:output_type -> enemy.DeleteEnemyResponse
+	17,  // 108: enemy.EnemyService.UndeleteEnemy:output_type -> enemy.UndeleteEnemyResponse
+	19,  // 109: enemy.EnemyService.PurgeEnemy:output_type -> enemy.PurgeEnemyResponse
+	22,  // 110: enemy.EnemyService.GetRatingHistory:output_type -> enemy.GetRatingHistoryResponse
+	25,  // 111: enemy.EnemyService.ListAuditEvents:output_type -> enemy.ListAuditEventsResponse
+	28,  // 112: enemy.EnemyService.WatchEnemies:output_type -> enemy.EnemyEvent
+	28,  // 113: enemy.EnemyService.SubscribeChanges:output_type -> enemy.EnemyEvent
+	30,  // 114: enemy.EnemyService.BatchAddEnemies:output_type -> enemy.BatchAddEnemiesResponse
+	33,  // 115: enemy.EnemyService.BatchGetEnemies:output_type -> enemy.BatchGetEnemiesResponse
+	35,  // 116: enemy.EnemyService.BatchUpdateEnemies:output_type -> enemy.BatchUpdateEnemiesResponse
+	37,  // 117: enemy.EnemyService.BulkLoadEnemies:output_type -> enemy.BulkLoadEnemiesResponse
+	40,  // 118: enemy.EnemyService.ExportEnemies:output_type -> enemy.ExportEnemiesResponse
+	42,  // 119: enemy.EnemyService.ImportEnemies:output_type -> enemy.ImportEnemiesResponse
+	44,  // 120: enemy.EnemyService.AddTags:output_type -> enemy.AddTagsResponse
+	46,  // 121: enemy.EnemyService.RemoveTags:output_type -> enemy.RemoveTagsResponse
+	48,  // 122: enemy.EnemyService.ListTags:output_type -> enemy.ListTagsResponse
+	52,  // 123: enemy.EnemyService.AddGrievance:output_type -> enemy.AddGrievanceResponse
+	54,  // 124: enemy.EnemyService.ListGrievances:output_type -> enemy.ListGrievancesResponse
+	56,  // 125: enemy.EnemyService.DeleteGrievance:output_type -> enemy.DeleteGrievanceResponse
+	59,  // 126: enemy.EnemyService.LinkEnemies:output_type -> enemy.LinkEnemiesResponse
+	61,  // 127: enemy.EnemyService.UnlinkEnemies:output_type -> enemy.UnlinkEnemiesResponse
+	64,  // 128: enemy.EnemyService.GetEnemyNetwork:output_type -> enemy.GetEnemyNetworkResponse
+	67,  // 129: enemy.EnemyService.GetLeaderboard:output_type -> enemy.GetLeaderboardResponse
+	70,  // 130: enemy.EnemyService.GetStats:output_type -> enemy.GetStatsResponse
+	73,  // 131: enemy.EnemyService.RecordConfrontation:output_type -> enemy.RecordConfrontationResponse
+	75,  // 132: enemy.EnemyService.RecomputeRatings:output_type -> enemy.RecomputeRatingsResponse
+	77,  // 133: enemy.EnemyService.CastVote:output_type -> enemy.CastVoteResponse
+	103, // [103:134] is the sub-list for method output_type
+	72,  // [72:103] is the sub-list for method input_type
+	72,  // [72:72] is the sub-list for extension type_name
+	72,  // [72:72] is the sub-list for extension extendee
+	0,   // [0:72] is the sub-list for field type_name
+}
+
+func init() { file_pkg_enemy_enemy_proto_init() }
+func file_pkg_enemy_enemy_proto_init() {
+	if File_pkg_enemy_enemy_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_pkg_enemy_enemy_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enemy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEnemyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddEnemyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnemyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnemyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEnemyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateEnemyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEnemiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEnemiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEnemyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEnemyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteEnemyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UndeleteEnemyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeEnemyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurgeEnemyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRatingHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RatingPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRatingHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAuditEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchEnemiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeChangesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnemyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchAddEnemiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchAddEnemiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchAddEnemyResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetEnemiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchGetEnemiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateEnemiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchUpdateEnemiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkLoadEnemiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BulkLoadEnemiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RejectedRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportEnemiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportEnemiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEnemiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportEnemiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTagsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddTagsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -641,8 +6397,8 @@ func file_pkg_enemy_enemy_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_enemy_enemy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddEnemyRequest); i {
+		file_pkg_enemy_enemy_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveTagsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -653,8 +6409,8 @@ func file_pkg_enemy_enemy_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_enemy_enemy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddEnemyResponse); i {
+		file_pkg_enemy_enemy_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -665,8 +6421,8 @@ func file_pkg_enemy_enemy_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_enemy_enemy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnemyRequest); i {
+		file_pkg_enemy_enemy_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTagsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -677,8 +6433,8 @@ func file_pkg_enemy_enemy_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_enemy_enemy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnemyResponse); i {
+		file_pkg_enemy_enemy_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TagCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -689,8 +6445,8 @@ func file_pkg_enemy_enemy_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_enemy_enemy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateEnemyRequest); i {
+		file_pkg_enemy_enemy_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Grievance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -701,8 +6457,8 @@ func file_pkg_enemy_enemy_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_enemy_enemy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateEnemyResponse); i {
+		file_pkg_enemy_enemy_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGrievanceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -713,8 +6469,8 @@ func file_pkg_enemy_enemy_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_enemy_enemy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListEnemiesRequest); i {
+		file_pkg_enemy_enemy_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddGrievanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -725,8 +6481,296 @@ func file_pkg_enemy_enemy_proto_init() {
 				return nil
 			}
 		}
-		file_pkg_enemy_enemy_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListEnemiesResponse); i {
+		file_pkg_enemy_enemy_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGrievancesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGrievancesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGrievanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteGrievanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Relationship); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkEnemiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkEnemiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlinkEnemiesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlinkEnemiesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnemyNetworkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetworkNode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEnemyNetworkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LeaderboardEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLeaderboardResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DailyChanges); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Confrontation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordConfrontationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecordConfrontationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecomputeRatingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecomputeRatingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CastVoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_enemy_enemy_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CastVoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -743,13 +6787,14 @@ func file_pkg_enemy_enemy_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_enemy_enemy_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   9,
+			NumEnums:      5,
+			NumMessages:   73,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_pkg_enemy_enemy_proto_goTypes,
 		DependencyIndexes: file_pkg_enemy_enemy_proto_depIdxs,
+		EnumInfos:         file_pkg_enemy_enemy_proto_enumTypes,
 		MessageInfos:      file_pkg_enemy_enemy_proto_msgTypes,
 	}.Build()
 	File_pkg_enemy_enemy_proto = out.File
@@ -21,6 +21,33 @@ type EnemyServiceClient interface {
 	GetEnemy(ctx context.Context, in *GetEnemyRequest, opts ...grpc.CallOption) (*GetEnemyResponse, error)
 	UpdateEnemy(ctx context.Context, in *UpdateEnemyRequest, opts ...grpc.CallOption) (*UpdateEnemyResponse, error)
 	ListEnemies(ctx context.Context, in *ListEnemiesRequest, opts ...grpc.CallOption) (*ListEnemiesResponse, error)
+	DeleteEnemy(ctx context.Context, in *DeleteEnemyRequest, opts ...grpc.CallOption) (*DeleteEnemyResponse, error)
+	UndeleteEnemy(ctx context.Context, in *UndeleteEnemyRequest, opts ...grpc.CallOption) (*UndeleteEnemyResponse, error)
+	PurgeEnemy(ctx context.Context, in *PurgeEnemyRequest, opts ...grpc.CallOption) (*PurgeEnemyResponse, error)
+	GetRatingHistory(ctx context.Context, in *GetRatingHistoryRequest, opts ...grpc.CallOption) (*GetRatingHistoryResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	WatchEnemies(ctx context.Context, in *WatchEnemiesRequest, opts ...grpc.CallOption) (EnemyService_WatchEnemiesClient, error)
+	SubscribeChanges(ctx context.Context, in *SubscribeChangesRequest, opts ...grpc.CallOption) (EnemyService_SubscribeChangesClient, error)
+	BatchAddEnemies(ctx context.Context, opts ...grpc.CallOption) (EnemyService_BatchAddEnemiesClient, error)
+	BatchGetEnemies(ctx context.Context, in *BatchGetEnemiesRequest, opts ...grpc.CallOption) (*BatchGetEnemiesResponse, error)
+	BatchUpdateEnemies(ctx context.Context, in *BatchUpdateEnemiesRequest, opts ...grpc.CallOption) (*BatchUpdateEnemiesResponse, error)
+	BulkLoadEnemies(ctx context.Context, opts ...grpc.CallOption) (EnemyService_BulkLoadEnemiesClient, error)
+	ExportEnemies(ctx context.Context, in *ExportEnemiesRequest, opts ...grpc.CallOption) (EnemyService_ExportEnemiesClient, error)
+	ImportEnemies(ctx context.Context, opts ...grpc.CallOption) (EnemyService_ImportEnemiesClient, error)
+	AddTags(ctx context.Context, in *AddTagsRequest, opts ...grpc.CallOption) (*AddTagsResponse, error)
+	RemoveTags(ctx context.Context, in *RemoveTagsRequest, opts ...grpc.CallOption) (*RemoveTagsResponse, error)
+	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
+	AddGrievance(ctx context.Context, in *AddGrievanceRequest, opts ...grpc.CallOption) (*AddGrievanceResponse, error)
+	ListGrievances(ctx context.Context, in *ListGrievancesRequest, opts ...grpc.CallOption) (*ListGrievancesResponse, error)
+	DeleteGrievance(ctx context.Context, in *DeleteGrievanceRequest, opts ...grpc.CallOption) (*DeleteGrievanceResponse, error)
+	LinkEnemies(ctx context.Context, in *LinkEnemiesRequest, opts ...grpc.CallOption) (*LinkEnemiesResponse, error)
+	UnlinkEnemies(ctx context.Context, in *UnlinkEnemiesRequest, opts ...grpc.CallOption) (*UnlinkEnemiesResponse, error)
+	GetEnemyNetwork(ctx context.Context, in *GetEnemyNetworkRequest, opts ...grpc.CallOption) (*GetEnemyNetworkResponse, error)
+	GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error)
+	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
+	RecordConfrontation(ctx context.Context, in *RecordConfrontationRequest, opts ...grpc.CallOption) (*RecordConfrontationResponse, error)
+	RecomputeRatings(ctx context.Context, in *RecomputeRatingsRequest, opts ...grpc.CallOption) (*RecomputeRatingsResponse, error)
+	CastVote(ctx context.Context, in *CastVoteRequest, opts ...grpc.CallOption) (*CastVoteResponse, error)
 }
 
 type enemyServiceClient struct {
@@ -67,6 +94,393 @@ func (c *enemyServiceClient) ListEnemies(ctx context.Context, in *ListEnemiesReq
 	return out, nil
 }
 
+func (c *enemyServiceClient) DeleteEnemy(ctx context.Context, in *DeleteEnemyRequest, opts ...grpc.CallOption) (*DeleteEnemyResponse, error) {
+	out := new(DeleteEnemyResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/DeleteEnemy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) UndeleteEnemy(ctx context.Context, in *UndeleteEnemyRequest, opts ...grpc.CallOption) (*UndeleteEnemyResponse, error) {
+	out := new(UndeleteEnemyResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/UndeleteEnemy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) PurgeEnemy(ctx context.Context, in *PurgeEnemyRequest, opts ...grpc.CallOption) (*PurgeEnemyResponse, error) {
+	out := new(PurgeEnemyResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/PurgeEnemy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) GetRatingHistory(ctx context.Context, in *GetRatingHistoryRequest, opts ...grpc.CallOption) (*GetRatingHistoryResponse, error) {
+	out := new(GetRatingHistoryResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/GetRatingHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/ListAuditEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) WatchEnemies(ctx context.Context, in *WatchEnemiesRequest, opts ...grpc.CallOption) (EnemyService_WatchEnemiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EnemyService_serviceDesc.Streams[0], "/enemy.EnemyService/WatchEnemies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enemyServiceWatchEnemiesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EnemyService_WatchEnemiesClient interface {
+	Recv() (*EnemyEvent, error)
+	grpc.ClientStream
+}
+
+type enemyServiceWatchEnemiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *enemyServiceWatchEnemiesClient) Recv() (*EnemyEvent, error) {
+	m := new(EnemyEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enemyServiceClient) SubscribeChanges(ctx context.Context, in *SubscribeChangesRequest, opts ...grpc.CallOption) (EnemyService_SubscribeChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EnemyService_serviceDesc.Streams[1], "/enemy.EnemyService/SubscribeChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enemyServiceSubscribeChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EnemyService_SubscribeChangesClient interface {
+	Recv() (*EnemyEvent, error)
+	grpc.ClientStream
+}
+
+type enemyServiceSubscribeChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *enemyServiceSubscribeChangesClient) Recv() (*EnemyEvent, error) {
+	m := new(EnemyEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enemyServiceClient) BatchAddEnemies(ctx context.Context, opts ...grpc.CallOption) (EnemyService_BatchAddEnemiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EnemyService_serviceDesc.Streams[2], "/enemy.EnemyService/BatchAddEnemies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enemyServiceBatchAddEnemiesClient{stream}
+	return x, nil
+}
+
+type EnemyService_BatchAddEnemiesClient interface {
+	Send(*BatchAddEnemiesRequest) error
+	CloseAndRecv() (*BatchAddEnemiesResponse, error)
+	grpc.ClientStream
+}
+
+type enemyServiceBatchAddEnemiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *enemyServiceBatchAddEnemiesClient) Send(m *BatchAddEnemiesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *enemyServiceBatchAddEnemiesClient) CloseAndRecv() (*BatchAddEnemiesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BatchAddEnemiesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enemyServiceClient) BatchGetEnemies(ctx context.Context, in *BatchGetEnemiesRequest, opts ...grpc.CallOption) (*BatchGetEnemiesResponse, error) {
+	out := new(BatchGetEnemiesResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/BatchGetEnemies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) BatchUpdateEnemies(ctx context.Context, in *BatchUpdateEnemiesRequest, opts ...grpc.CallOption) (*BatchUpdateEnemiesResponse, error) {
+	out := new(BatchUpdateEnemiesResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/BatchUpdateEnemies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) BulkLoadEnemies(ctx context.Context, opts ...grpc.CallOption) (EnemyService_BulkLoadEnemiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EnemyService_serviceDesc.Streams[3], "/enemy.EnemyService/BulkLoadEnemies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enemyServiceBulkLoadEnemiesClient{stream}
+	return x, nil
+}
+
+type EnemyService_BulkLoadEnemiesClient interface {
+	Send(*BulkLoadEnemiesRequest) error
+	CloseAndRecv() (*BulkLoadEnemiesResponse, error)
+	grpc.ClientStream
+}
+
+type enemyServiceBulkLoadEnemiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *enemyServiceBulkLoadEnemiesClient) Send(m *BulkLoadEnemiesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *enemyServiceBulkLoadEnemiesClient) CloseAndRecv() (*BulkLoadEnemiesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(BulkLoadEnemiesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enemyServiceClient) ExportEnemies(ctx context.Context, in *ExportEnemiesRequest, opts ...grpc.CallOption) (EnemyService_ExportEnemiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EnemyService_serviceDesc.Streams[4], "/enemy.EnemyService/ExportEnemies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enemyServiceExportEnemiesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type EnemyService_ExportEnemiesClient interface {
+	Recv() (*ExportEnemiesResponse, error)
+	grpc.ClientStream
+}
+
+type enemyServiceExportEnemiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *enemyServiceExportEnemiesClient) Recv() (*ExportEnemiesResponse, error) {
+	m := new(ExportEnemiesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enemyServiceClient) ImportEnemies(ctx context.Context, opts ...grpc.CallOption) (EnemyService_ImportEnemiesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_EnemyService_serviceDesc.Streams[5], "/enemy.EnemyService/ImportEnemies", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &enemyServiceImportEnemiesClient{stream}
+	return x, nil
+}
+
+type EnemyService_ImportEnemiesClient interface {
+	Send(*ImportEnemiesRequest) error
+	CloseAndRecv() (*ImportEnemiesResponse, error)
+	grpc.ClientStream
+}
+
+type enemyServiceImportEnemiesClient struct {
+	grpc.ClientStream
+}
+
+func (x *enemyServiceImportEnemiesClient) Send(m *ImportEnemiesRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *enemyServiceImportEnemiesClient) CloseAndRecv() (*ImportEnemiesResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportEnemiesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *enemyServiceClient) AddTags(ctx context.Context, in *AddTagsRequest, opts ...grpc.CallOption) (*AddTagsResponse, error) {
+	out := new(AddTagsResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/AddTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) RemoveTags(ctx context.Context, in *RemoveTagsRequest, opts ...grpc.CallOption) (*RemoveTagsResponse, error) {
+	out := new(RemoveTagsResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/RemoveTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error) {
+	out := new(ListTagsResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/ListTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) AddGrievance(ctx context.Context, in *AddGrievanceRequest, opts ...grpc.CallOption) (*AddGrievanceResponse, error) {
+	out := new(AddGrievanceResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/AddGrievance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) ListGrievances(ctx context.Context, in *ListGrievancesRequest, opts ...grpc.CallOption) (*ListGrievancesResponse, error) {
+	out := new(ListGrievancesResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/ListGrievances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) DeleteGrievance(ctx context.Context, in *DeleteGrievanceRequest, opts ...grpc.CallOption) (*DeleteGrievanceResponse, error) {
+	out := new(DeleteGrievanceResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/DeleteGrievance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) LinkEnemies(ctx context.Context, in *LinkEnemiesRequest, opts ...grpc.CallOption) (*LinkEnemiesResponse, error) {
+	out := new(LinkEnemiesResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/LinkEnemies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) UnlinkEnemies(ctx context.Context, in *UnlinkEnemiesRequest, opts ...grpc.CallOption) (*UnlinkEnemiesResponse, error) {
+	out := new(UnlinkEnemiesResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/UnlinkEnemies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) GetEnemyNetwork(ctx context.Context, in *GetEnemyNetworkRequest, opts ...grpc.CallOption) (*GetEnemyNetworkResponse, error) {
+	out := new(GetEnemyNetworkResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/GetEnemyNetwork", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) GetLeaderboard(ctx context.Context, in *GetLeaderboardRequest, opts ...grpc.CallOption) (*GetLeaderboardResponse, error) {
+	out := new(GetLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/GetLeaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error) {
+	out := new(GetStatsResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/GetStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) RecordConfrontation(ctx context.Context, in *RecordConfrontationRequest, opts ...grpc.CallOption) (*RecordConfrontationResponse, error) {
+	out := new(RecordConfrontationResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/RecordConfrontation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) RecomputeRatings(ctx context.Context, in *RecomputeRatingsRequest, opts ...grpc.CallOption) (*RecomputeRatingsResponse, error) {
+	out := new(RecomputeRatingsResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/RecomputeRatings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *enemyServiceClient) CastVote(ctx context.Context, in *CastVoteRequest, opts ...grpc.CallOption) (*CastVoteResponse, error) {
+	out := new(CastVoteResponse)
+	err := c.cc.Invoke(ctx, "/enemy.EnemyService/CastVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnemyServiceServer is the server API for EnemyService service.
 // All implementations must embed UnimplementedEnemyServiceServer
 // for forward compatibility
@@ -75,6 +489,33 @@ type EnemyServiceServer interface {
 	GetEnemy(context.Context, *GetEnemyRequest) (*GetEnemyResponse, error)
 	UpdateEnemy(context.Context, *UpdateEnemyRequest) (*UpdateEnemyResponse, error)
 	ListEnemies(context.Context, *ListEnemiesRequest) (*ListEnemiesResponse, error)
+	DeleteEnemy(context.Context, *DeleteEnemyRequest) (*DeleteEnemyResponse, error)
+	UndeleteEnemy(context.Context, *UndeleteEnemyRequest) (*UndeleteEnemyResponse, error)
+	PurgeEnemy(context.Context, *PurgeEnemyRequest) (*PurgeEnemyResponse, error)
+	GetRatingHistory(context.Context, *GetRatingHistoryRequest) (*GetRatingHistoryResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	WatchEnemies(*WatchEnemiesRequest, EnemyService_WatchEnemiesServer) error
+	SubscribeChanges(*SubscribeChangesRequest, EnemyService_SubscribeChangesServer) error
+	BatchAddEnemies(EnemyService_BatchAddEnemiesServer) error
+	BatchGetEnemies(context.Context, *BatchGetEnemiesRequest) (*BatchGetEnemiesResponse, error)
+	BatchUpdateEnemies(context.Context, *BatchUpdateEnemiesRequest) (*BatchUpdateEnemiesResponse, error)
+	BulkLoadEnemies(EnemyService_BulkLoadEnemiesServer) error
+	ExportEnemies(*ExportEnemiesRequest, EnemyService_ExportEnemiesServer) error
+	ImportEnemies(EnemyService_ImportEnemiesServer) error
+	AddTags(context.Context, *AddTagsRequest) (*AddTagsResponse, error)
+	RemoveTags(context.Context, *RemoveTagsRequest) (*RemoveTagsResponse, error)
+	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
+	AddGrievance(context.Context, *AddGrievanceRequest) (*AddGrievanceResponse, error)
+	ListGrievances(context.Context, *ListGrievancesRequest) (*ListGrievancesResponse, error)
+	DeleteGrievance(context.Context, *DeleteGrievanceRequest) (*DeleteGrievanceResponse, error)
+	LinkEnemies(context.Context, *LinkEnemiesRequest) (*LinkEnemiesResponse, error)
+	UnlinkEnemies(context.Context, *UnlinkEnemiesRequest) (*UnlinkEnemiesResponse, error)
+	GetEnemyNetwork(context.Context, *GetEnemyNetworkRequest) (*GetEnemyNetworkResponse, error)
+	GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error)
+	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
+	RecordConfrontation(context.Context, *RecordConfrontationRequest) (*RecordConfrontationResponse, error)
+	RecomputeRatings(context.Context, *RecomputeRatingsRequest) (*RecomputeRatingsResponse, error)
+	CastVote(context.Context, *CastVoteRequest) (*CastVoteResponse, error)
 	mustEmbedUnimplementedEnemyServiceServer()
 }
 
@@ -94,6 +535,87 @@ func (UnimplementedEnemyServiceServer) UpdateEnemy(context.Context, *UpdateEnemy
 func (UnimplementedEnemyServiceServer) ListEnemies(context.Context, *ListEnemiesRequest) (*ListEnemiesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListEnemies not implemented")
 }
+func (UnimplementedEnemyServiceServer) DeleteEnemy(context.Context, *DeleteEnemyRequest) (*DeleteEnemyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteEnemy not implemented")
+}
+func (UnimplementedEnemyServiceServer) UndeleteEnemy(context.Context, *UndeleteEnemyRequest) (*UndeleteEnemyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteEnemy not implemented")
+}
+func (UnimplementedEnemyServiceServer) PurgeEnemy(context.Context, *PurgeEnemyRequest) (*PurgeEnemyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeEnemy not implemented")
+}
+func (UnimplementedEnemyServiceServer) GetRatingHistory(context.Context, *GetRatingHistoryRequest) (*GetRatingHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatingHistory not implemented")
+}
+func (UnimplementedEnemyServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedEnemyServiceServer) WatchEnemies(*WatchEnemiesRequest, EnemyService_WatchEnemiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEnemies not implemented")
+}
+func (UnimplementedEnemyServiceServer) SubscribeChanges(*SubscribeChangesRequest, EnemyService_SubscribeChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeChanges not implemented")
+}
+func (UnimplementedEnemyServiceServer) BatchAddEnemies(EnemyService_BatchAddEnemiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method BatchAddEnemies not implemented")
+}
+func (UnimplementedEnemyServiceServer) BatchGetEnemies(context.Context, *BatchGetEnemiesRequest) (*BatchGetEnemiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetEnemies not implemented")
+}
+func (UnimplementedEnemyServiceServer) BatchUpdateEnemies(context.Context, *BatchUpdateEnemiesRequest) (*BatchUpdateEnemiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateEnemies not implemented")
+}
+func (UnimplementedEnemyServiceServer) BulkLoadEnemies(EnemyService_BulkLoadEnemiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method BulkLoadEnemies not implemented")
+}
+func (UnimplementedEnemyServiceServer) ExportEnemies(*ExportEnemiesRequest, EnemyService_ExportEnemiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportEnemies not implemented")
+}
+func (UnimplementedEnemyServiceServer) ImportEnemies(EnemyService_ImportEnemiesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportEnemies not implemented")
+}
+func (UnimplementedEnemyServiceServer) AddTags(context.Context, *AddTagsRequest) (*AddTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTags not implemented")
+}
+func (UnimplementedEnemyServiceServer) RemoveTags(context.Context, *RemoveTagsRequest) (*RemoveTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveTags not implemented")
+}
+func (UnimplementedEnemyServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
+}
+func (UnimplementedEnemyServiceServer) AddGrievance(context.Context, *AddGrievanceRequest) (*AddGrievanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGrievance not implemented")
+}
+func (UnimplementedEnemyServiceServer) ListGrievances(context.Context, *ListGrievancesRequest) (*ListGrievancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrievances not implemented")
+}
+func (UnimplementedEnemyServiceServer) DeleteGrievance(context.Context, *DeleteGrievanceRequest) (*DeleteGrievanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGrievance not implemented")
+}
+func (UnimplementedEnemyServiceServer) LinkEnemies(context.Context, *LinkEnemiesRequest) (*LinkEnemiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkEnemies not implemented")
+}
+func (UnimplementedEnemyServiceServer) UnlinkEnemies(context.Context, *UnlinkEnemiesRequest) (*UnlinkEnemiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkEnemies not implemented")
+}
+func (UnimplementedEnemyServiceServer) GetEnemyNetwork(context.Context, *GetEnemyNetworkRequest) (*GetEnemyNetworkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnemyNetwork not implemented")
+}
+func (UnimplementedEnemyServiceServer) GetLeaderboard(context.Context, *GetLeaderboardRequest) (*GetLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLeaderboard not implemented")
+}
+func (UnimplementedEnemyServiceServer) GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStats not implemented")
+}
+func (UnimplementedEnemyServiceServer) RecordConfrontation(context.Context, *RecordConfrontationRequest) (*RecordConfrontationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordConfrontation not implemented")
+}
+func (UnimplementedEnemyServiceServer) RecomputeRatings(context.Context, *RecomputeRatingsRequest) (*RecomputeRatingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecomputeRatings not implemented")
+}
+func (UnimplementedEnemyServiceServer) CastVote(context.Context, *CastVoteRequest) (*CastVoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CastVote not implemented")
+}
 func (UnimplementedEnemyServiceServer) mustEmbedUnimplementedEnemyServiceServer() {}
 
 // UnsafeEnemyServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -179,7 +701,526 @@ func _EnemyService_ListEnemies_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _EnemyService_serviceDesc = grpc.ServiceDesc{
+func _EnemyService_DeleteEnemy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteEnemyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).DeleteEnemy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/DeleteEnemy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).DeleteEnemy(ctx, req.(*DeleteEnemyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_UndeleteEnemy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteEnemyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).UndeleteEnemy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/UndeleteEnemy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).UndeleteEnemy(ctx, req.(*UndeleteEnemyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_PurgeEnemy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeEnemyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).PurgeEnemy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/PurgeEnemy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).PurgeEnemy(ctx, req.(*PurgeEnemyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_GetRatingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRatingHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).GetRatingHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/GetRatingHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).GetRatingHistory(ctx, req.(*GetRatingHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/ListAuditEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_WatchEnemies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEnemiesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnemyServiceServer).WatchEnemies(m, &enemyServiceWatchEnemiesServer{stream})
+}
+
+type EnemyService_WatchEnemiesServer interface {
+	Send(*EnemyEvent) error
+	grpc.ServerStream
+}
+
+type enemyServiceWatchEnemiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *enemyServiceWatchEnemiesServer) Send(m *EnemyEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EnemyService_SubscribeChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnemyServiceServer).SubscribeChanges(m, &enemyServiceSubscribeChangesServer{stream})
+}
+
+type EnemyService_SubscribeChangesServer interface {
+	Send(*EnemyEvent) error
+	grpc.ServerStream
+}
+
+type enemyServiceSubscribeChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *enemyServiceSubscribeChangesServer) Send(m *EnemyEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EnemyService_BatchAddEnemies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EnemyServiceServer).BatchAddEnemies(&enemyServiceBatchAddEnemiesServer{stream})
+}
+
+type EnemyService_BatchAddEnemiesServer interface {
+	SendAndClose(*BatchAddEnemiesResponse) error
+	Recv() (*BatchAddEnemiesRequest, error)
+	grpc.ServerStream
+}
+
+type enemyServiceBatchAddEnemiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *enemyServiceBatchAddEnemiesServer) SendAndClose(m *BatchAddEnemiesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *enemyServiceBatchAddEnemiesServer) Recv() (*BatchAddEnemiesRequest, error) {
+	m := new(BatchAddEnemiesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _EnemyService_BatchGetEnemies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetEnemiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).BatchGetEnemies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/BatchGetEnemies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).BatchGetEnemies(ctx, req.(*BatchGetEnemiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_BatchUpdateEnemies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateEnemiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).BatchUpdateEnemies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/BatchUpdateEnemies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).BatchUpdateEnemies(ctx, req.(*BatchUpdateEnemiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_BulkLoadEnemies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EnemyServiceServer).BulkLoadEnemies(&enemyServiceBulkLoadEnemiesServer{stream})
+}
+
+type EnemyService_BulkLoadEnemiesServer interface {
+	SendAndClose(*BulkLoadEnemiesResponse) error
+	Recv() (*BulkLoadEnemiesRequest, error)
+	grpc.ServerStream
+}
+
+type enemyServiceBulkLoadEnemiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *enemyServiceBulkLoadEnemiesServer) SendAndClose(m *BulkLoadEnemiesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *enemyServiceBulkLoadEnemiesServer) Recv() (*BulkLoadEnemiesRequest, error) {
+	m := new(BulkLoadEnemiesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _EnemyService_ExportEnemies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportEnemiesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EnemyServiceServer).ExportEnemies(m, &enemyServiceExportEnemiesServer{stream})
+}
+
+type EnemyService_ExportEnemiesServer interface {
+	Send(*ExportEnemiesResponse) error
+	grpc.ServerStream
+}
+
+type enemyServiceExportEnemiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *enemyServiceExportEnemiesServer) Send(m *ExportEnemiesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _EnemyService_ImportEnemies_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(EnemyServiceServer).ImportEnemies(&enemyServiceImportEnemiesServer{stream})
+}
+
+type EnemyService_ImportEnemiesServer interface {
+	SendAndClose(*ImportEnemiesResponse) error
+	Recv() (*ImportEnemiesRequest, error)
+	grpc.ServerStream
+}
+
+type enemyServiceImportEnemiesServer struct {
+	grpc.ServerStream
+}
+
+func (x *enemyServiceImportEnemiesServer) SendAndClose(m *ImportEnemiesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *enemyServiceImportEnemiesServer) Recv() (*ImportEnemiesRequest, error) {
+	m := new(ImportEnemiesRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _EnemyService_AddTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).AddTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/AddTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).AddTags(ctx, req.(*AddTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_RemoveTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).RemoveTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/RemoveTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).RemoveTags(ctx, req.(*RemoveTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_ListTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).ListTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/ListTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).ListTags(ctx, req.(*ListTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_AddGrievance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGrievanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).AddGrievance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/AddGrievance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).AddGrievance(ctx, req.(*AddGrievanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_ListGrievances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGrievancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).ListGrievances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/ListGrievances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).ListGrievances(ctx, req.(*ListGrievancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_DeleteGrievance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGrievanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).DeleteGrievance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/DeleteGrievance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).DeleteGrievance(ctx, req.(*DeleteGrievanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_LinkEnemies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkEnemiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).LinkEnemies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/LinkEnemies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).LinkEnemies(ctx, req.(*LinkEnemiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_UnlinkEnemies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkEnemiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).UnlinkEnemies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/UnlinkEnemies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).UnlinkEnemies(ctx, req.(*UnlinkEnemiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_GetEnemyNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEnemyNetworkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).GetEnemyNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/GetEnemyNetwork",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).GetEnemyNetwork(ctx, req.(*GetEnemyNetworkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_GetLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).GetLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/GetLeaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).GetLeaderboard(ctx, req.(*GetLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_GetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).GetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/GetStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).GetStats(ctx, req.(*GetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_RecordConfrontation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordConfrontationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).RecordConfrontation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/RecordConfrontation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).RecordConfrontation(ctx, req.(*RecordConfrontationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_RecomputeRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecomputeRatingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).RecomputeRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/RecomputeRatings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).RecomputeRatings(ctx, req.(*RecomputeRatingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnemyService_CastVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CastVoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnemyServiceServer).CastVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/enemy.EnemyService/CastVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnemyServiceServer).CastVote(ctx, req.(*CastVoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EnemyService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "enemy.EnemyService",
 	HandlerType: (*EnemyServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
@@ -199,7 +1240,122 @@ var _EnemyService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListEnemies",
 			Handler:    _EnemyService_ListEnemies_Handler,
 		},
+		{
+			MethodName: "DeleteEnemy",
+			Handler:    _EnemyService_DeleteEnemy_Handler,
+		},
+		{
+			MethodName: "UndeleteEnemy",
+			Handler:    _EnemyService_UndeleteEnemy_Handler,
+		},
+		{
+			MethodName: "PurgeEnemy",
+			Handler:    _EnemyService_PurgeEnemy_Handler,
+		},
+		{
+			MethodName: "GetRatingHistory",
+			Handler:    _EnemyService_GetRatingHistory_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _EnemyService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "BatchGetEnemies",
+			Handler:    _EnemyService_BatchGetEnemies_Handler,
+		},
+		{
+			MethodName: "BatchUpdateEnemies",
+			Handler:    _EnemyService_BatchUpdateEnemies_Handler,
+		},
+		{
+			MethodName: "AddTags",
+			Handler:    _EnemyService_AddTags_Handler,
+		},
+		{
+			MethodName: "RemoveTags",
+			Handler:    _EnemyService_RemoveTags_Handler,
+		},
+		{
+			MethodName: "ListTags",
+			Handler:    _EnemyService_ListTags_Handler,
+		},
+		{
+			MethodName: "AddGrievance",
+			Handler:    _EnemyService_AddGrievance_Handler,
+		},
+		{
+			MethodName: "ListGrievances",
+			Handler:    _EnemyService_ListGrievances_Handler,
+		},
+		{
+			MethodName: "DeleteGrievance",
+			Handler:    _EnemyService_DeleteGrievance_Handler,
+		},
+		{
+			MethodName: "LinkEnemies",
+			Handler:    _EnemyService_LinkEnemies_Handler,
+		},
+		{
+			MethodName: "UnlinkEnemies",
+			Handler:    _EnemyService_UnlinkEnemies_Handler,
+		},
+		{
+			MethodName: "GetEnemyNetwork",
+			Handler:    _EnemyService_GetEnemyNetwork_Handler,
+		},
+		{
+			MethodName: "GetLeaderboard",
+			Handler:    _EnemyService_GetLeaderboard_Handler,
+		},
+		{
+			MethodName: "GetStats",
+			Handler:    _EnemyService_GetStats_Handler,
+		},
+		{
+			MethodName: "RecordConfrontation",
+			Handler:    _EnemyService_RecordConfrontation_Handler,
+		},
+		{
+			MethodName: "RecomputeRatings",
+			Handler:    _EnemyService_RecomputeRatings_Handler,
+		},
+		{
+			MethodName: "CastVote",
+			Handler:    _EnemyService_CastVote_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchEnemies",
+			Handler:       _EnemyService_WatchEnemies_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeChanges",
+			Handler:       _EnemyService_SubscribeChanges_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BatchAddEnemies",
+			Handler:       _EnemyService_BatchAddEnemies_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "BulkLoadEnemies",
+			Handler:       _EnemyService_BulkLoadEnemies_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportEnemies",
+			Handler:       _EnemyService_ExportEnemies_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportEnemies",
+			Handler:       _EnemyService_ImportEnemies_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/enemy/enemy.proto",
 }